@@ -0,0 +1,120 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// appAlertSummary is an app alert with its rule and destinations.
+type appAlertSummary struct {
+	ID            string                       `json:"id"`
+	Component     string                       `json:"component,omitempty"`
+	Rule          string                       `json:"rule"`
+	Operator      string                       `json:"operator,omitempty"`
+	Value         float32                      `json:"value,omitempty"`
+	Window        string                       `json:"window,omitempty"`
+	Disabled      bool                         `json:"disabled,omitempty"`
+	Phase         string                       `json:"phase,omitempty"`
+	Emails        []string                     `json:"emails"`
+	SlackWebhooks []*godo.AppAlertSlackWebhook `json:"slack_webhooks"`
+}
+
+// summarizeAlert flattens an app alert.
+func summarizeAlert(alert *godo.AppAlert) appAlertSummary {
+	summary := appAlertSummary{
+		ID:            alert.ID,
+		Component:     alert.ComponentName,
+		Phase:         string(alert.Phase),
+		Emails:        alert.Emails,
+		SlackWebhooks: alert.SlackWebhooks,
+	}
+	if summary.Emails == nil {
+		summary.Emails = []string{}
+	}
+	if summary.SlackWebhooks == nil {
+		summary.SlackWebhooks = []*godo.AppAlertSlackWebhook{}
+	}
+	if alert.Spec != nil {
+		summary.Rule = string(alert.Spec.Rule)
+		summary.Operator = string(alert.Spec.Operator)
+		summary.Value = alert.Spec.Value
+		summary.Window = string(alert.Spec.Window)
+		summary.Disabled = alert.Spec.Disabled
+	}
+	return summary
+}
+
+// listAlerts lists the alerts of an app and its components with their destinations.
+func (a *AppPlatformTool) listAlerts(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, ok := req.GetArguments()["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+
+	alerts, _, err := a.client.Apps.ListAlerts(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	summaries := make([]appAlertSummary, 0, len(alerts))
+	for _, alert := range alerts {
+		summaries = append(summaries, summarizeAlert(alert))
+	}
+	summariesJSON, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(summariesJSON)), nil
+}
+
+// updateAlertDestinations replaces the email and Slack destinations of an app alert.
+func (a *AppPlatformTool) updateAlertDestinations(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	alertID, ok := args["AlertID"].(string)
+	if !ok || alertID == "" {
+		return mcp.NewToolResultError("Alert ID is required"), nil
+	}
+
+	update := &godo.AlertDestinationUpdateRequest{Emails: []string{}, SlackWebhooks: []*godo.AppAlertSlackWebhook{}}
+	if raw, ok := args["Emails"].([]any); ok {
+		for _, e := range raw {
+			if email, ok := e.(string); ok && email != "" {
+				update.Emails = append(update.Emails, email)
+			}
+		}
+	}
+	if raw, ok := args["SlackWebhooks"].([]any); ok {
+		for _, item := range raw {
+			m, ok := item.(map[string]any)
+			if !ok {
+				return mcp.NewToolResultError("SlackWebhooks must be a list of objects with URL and Channel"), nil
+			}
+			webhookURL, _ := m["URL"].(string)
+			channel, _ := m["Channel"].(string)
+			if webhookURL == "" {
+				return mcp.NewToolResultError("URL is required for each Slack webhook"), nil
+			}
+			update.SlackWebhooks = append(update.SlackWebhooks, &godo.AppAlertSlackWebhook{URL: webhookURL, Channel: channel})
+		}
+	}
+	if len(update.Emails) == 0 && len(update.SlackWebhooks) == 0 {
+		return mcp.NewToolResultError("At least one email or Slack webhook is required"), nil
+	}
+
+	alert, _, err := a.client.Apps.UpdateAlertDestinations(ctx, appID, alertID, update)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	alertJSON, err := json.MarshalIndent(summarizeAlert(alert), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(alertJSON)), nil
+}
@@ -0,0 +1,93 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListAlerts(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().ListAlerts(gomock.Any(), "app-123").Return([]*godo.AppAlert{
+		{
+			ID:            "alert-1",
+			ComponentName: "web",
+			Spec:          &godo.AppAlertSpec{Rule: godo.AppAlertSpecRule_CPUUtilization, Operator: godo.AppAlertSpecOperator_GreaterThan, Value: 80, Window: godo.AppAlertSpecWindow_FiveMinutes},
+			Emails:        []string{"oncall@example.com"},
+		},
+		{ID: "alert-2", Spec: &godo.AppAlertSpec{Rule: godo.AppAlertSpecRule_DeploymentFailed}},
+	}, nil, nil).Times(1)
+
+	resp, err := tool.listAlerts(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var alerts []appAlertSummary
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &alerts))
+	require.Equal(t, []appAlertSummary{
+		{ID: "alert-1", Component: "web", Rule: "CPU_UTILIZATION", Operator: "GREATER_THAN", Value: 80, Window: "FIVE_MINUTES", Emails: []string{"oncall@example.com"}, SlackWebhooks: []*godo.AppAlertSlackWebhook{}},
+		{ID: "alert-2", Rule: "DEPLOYMENT_FAILED", Emails: []string{}, SlackWebhooks: []*godo.AppAlertSlackWebhook{}},
+	}, alerts)
+}
+
+func TestUpdateAlertDestinations(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mock        func(app *MockAppsService)
+		expectError bool
+	}{
+		{
+			name: "Replace destinations",
+			args: map[string]any{
+				"AppID":         "app-123",
+				"AlertID":       "alert-1",
+				"Emails":        []any{"oncall@example.com"},
+				"SlackWebhooks": []any{map[string]any{"URL": "https://hooks.slack.com/services/x", "Channel": "#alerts"}},
+			},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().UpdateAlertDestinations(gomock.Any(), "app-123", "alert-1", &godo.AlertDestinationUpdateRequest{
+					Emails:        []string{"oncall@example.com"},
+					SlackWebhooks: []*godo.AppAlertSlackWebhook{{URL: "https://hooks.slack.com/services/x", Channel: "#alerts"}},
+				}).Return(&godo.AppAlert{ID: "alert-1"}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"AppID": "app-123", "AlertID": "alert-1", "Emails": []any{"unverified@example.com"}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().UpdateAlertDestinations(gomock.Any(), "app-123", "alert-1", gomock.Any()).Return(nil, nil, fmt.Errorf("email not verified")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "No destinations",
+			args:        map[string]any{"AppID": "app-123", "AlertID": "alert-1"},
+			expectError: true,
+		},
+		{
+			name:        "Slack webhook without URL",
+			args:        map[string]any{"AppID": "app-123", "AlertID": "alert-1", "SlackWebhooks": []any{map[string]any{"Channel": "#alerts"}}},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			resp, err := tool.updateAlertDestinations(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
@@ -18,7 +18,10 @@ const (
 )
 
 type AppPlatformTool struct {
-	client *godo.Client
+	client     *godo.Client
+	proposals  proposalStore
+	oneOffJobs oneOffJobStore
+	specSchema map[string]any // schema of the app spec, set by Tools from the app create schema
 }
 
 // NewAppPlatformTool creates a new AppsTool instance
@@ -166,6 +169,8 @@ type AppUpdateRequest struct {
 	Request *godo.AppUpdateRequest `json:"request"`
 	// AppID is the ID of the app to update
 	AppID string `json:"app_id"`
+	// ProposalID is the ID of a proposal made with apps-spec-propose to apply instead of a request
+	ProposalID string `json:"proposal_id,omitempty"`
 }
 
 // updateApp updates an existing app by its ID. If the spec is not provided, this simply forces a re-deploy of the app.
@@ -180,6 +185,13 @@ func (a *AppPlatformTool) updateApp(ctx context.Context, req mcp.CallToolRequest
 		return mcp.NewToolResultErrorFromErr("parse app spec", err), nil
 	}
 
+	if update.Update.ProposalID != "" {
+		if update.Update.Request != nil {
+			return mcp.NewToolResultError("Provide either a proposal ID or an update request, not both"), nil
+		}
+		return a.applyProposal(ctx, update.Update.AppID, update.Update.ProposalID)
+	}
+
 	if update.Update.Request == nil {
 		deployment, _, err := a.client.Apps.CreateDeployment(ctx, update.Update.AppID, &godo.DeploymentCreateRequest{
 			ForceBuild: true,
@@ -239,18 +251,293 @@ func (a *AppPlatformTool) Tools() []server.ServerTool {
 				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to retrieve information for")),
 			),
 		},
+		{
+			Handler: a.getLogs,
+			Tool: mcp.NewTool("apps-get-logs",
+				mcp.WithDescription("Get the build, deploy, run or crashed-run logs of an app component, to diagnose failed deployments or runtime errors. Returns the last lines of the logs, optionally only those matching a regular expression."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to retrieve logs for")),
+				mcp.WithString("Type", mcp.DefaultString("run"), mcp.Enum("build", "deploy", "run", "run_restarted"), mcp.Description("The type of logs: build, deploy, run, or run_restarted for the logs of crashed and restarted instances")),
+				mcp.WithString("Component", mcp.Description("The name of the component (service, worker, job or static site) to retrieve logs for. Defaults to all components.")),
+				mcp.WithString("DeploymentID", mcp.Description("The deployment to retrieve logs for. Defaults to the most recent deployment for build and deploy logs, and to the active deployment for run logs.")),
+				mcp.WithNumber("TailLines", mcp.DefaultNumber(defaultLogTailLines), mcp.Description("The number of lines to return from the end of the logs (max 5000)")),
+				mcp.WithString("Filter", mcp.Description("A regular expression; only matching lines are returned (e.g., 'error|panic')")),
+				mcp.WithBoolean("IgnoreCase", mcp.DefaultBool(false), mcp.Description("Match the filter case-insensitively")),
+			),
+		},
+		{
+			Handler: a.listDeployments,
+			Tool: mcp.NewTool("apps-list-deployments",
+				mcp.WithDescription("List the deployment history of an app on DigitalOcean App Platform, most recent first, with the phase, cause, progress and failed steps of each deployment"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to list deployments for")),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultPage), mcp.Description("The page number to retrieve (default is 1)")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultPageSize), mcp.Description("The number of deployments per page (default is 30)")),
+			),
+		},
+		{
+			Handler: a.getDeployment,
+			Tool: mcp.NewTool("apps-get-deployment",
+				mcp.WithDescription("Get a single deployment of an app on DigitalOcean App Platform, with all of its progress steps and the app spec it deployed"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("DeploymentID", mcp.Required(), mcp.Description("The ID of the deployment to retrieve")),
+			),
+		},
+		{
+			Handler: a.validateRollback,
+			Tool: mcp.NewTool("apps-validate-rollback",
+				mcp.WithDescription("Check whether an app can be rolled back to a previous deployment, returning the blocking error and warnings, if any"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to roll back")),
+				mcp.WithString("DeploymentID", mcp.Required(), mcp.Description("The ID of the previous deployment to roll back to")),
+				mcp.WithBoolean("SkipPin", mcp.DefaultBool(false), mcp.Description("Whether to skip pinning the app to the rollback deployment")),
+			),
+		},
+		{
+			Handler: a.rollbackApp,
+			Tool: mcp.NewTool("apps-rollback",
+				mcp.WithDescription("Roll an app back to a previous deployment. Unless SkipPin is set, the app is pinned to that deployment and new deployments are blocked until the rollback is committed or reverted."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to roll back")),
+				mcp.WithString("DeploymentID", mcp.Required(), mcp.Description("The ID of the previous deployment to roll back to")),
+				mcp.WithBoolean("SkipPin", mcp.DefaultBool(false), mcp.Description("Whether to skip pinning the app to the rollback deployment")),
+			),
+		},
+		{
+			Handler: a.commitRollback,
+			Tool: mcp.NewTool("apps-commit-rollback",
+				mcp.WithDescription("Commit the pending rollback of an app, keeping the rolled back deployment and allowing new deployments again"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app with a pending rollback")),
+			),
+		},
+		{
+			Handler: a.revertRollback,
+			Tool: mcp.NewTool("apps-revert-rollback",
+				mcp.WithDescription("Revert the pending rollback of an app, redeploying the deployment that was active before the rollback"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app with a pending rollback")),
+			),
+		},
+		{
+			Handler: a.proposeSpec,
+			Tool: mcp.NewTool("apps-spec-propose",
+				mcp.WithDescription("Propose a change to the spec of an app without applying it. The patch is merged into the current spec as a JSON merge patch (RFC 7396): objects are merged, null removes a field, and lists such as services or envs are replaced entirely, so include every element that should remain. The result is validated, and the structured diff and monthly cost change are returned with a proposal ID to apply with apps-update. Secret values are redacted in the output."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to change")),
+				mcp.WithObject("Patch", mcp.Required(), mcp.Description("A JSON merge patch against the current app spec (e.g., {\"services\": [...]} or {\"alerts\": null})")),
+			),
+		},
+		{
+			Handler: a.exportSpecYAML,
+			Tool: mcp.NewTool("apps-spec-export",
+				mcp.WithDescription("Export the spec of an app on DigitalOcean App Platform as YAML, in the format used by doctl and .do/app.yaml files"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to export")),
+				mcp.WithString("Path", mcp.Description("A local file to write the spec to (e.g., .do/app.yaml). The spec is only returned when omitted.")),
+			),
+		},
+		{
+			Handler: a.importSpecYAML,
+			Tool: mcp.NewTool("apps-spec-import",
+				mcp.WithDescription("Create an app on DigitalOcean App Platform from a YAML spec, such as a .do/app.yaml file, or update an existing app with it. The spec is validated against the app spec schema before it is sent."),
+				mcp.WithString("YAML", mcp.Description("The YAML app spec. Either YAML or Path is required.")),
+				mcp.WithString("Path", mcp.Description("A local YAML file with the app spec (e.g., .do/app.yaml). Either YAML or Path is required.")),
+				mcp.WithString("AppID", mcp.Description("The application ID of the app to update. A new app is created when omitted.")),
+				mcp.WithString("ProjectID", mcp.Description("The project to create the app in. Defaults to the default project.")),
+				mcp.WithBoolean("DryRun", mcp.DefaultBool(false), mcp.Description("Only validate the spec with App Platform and return the proposal, including its monthly cost")),
+			),
+		},
+		{
+			Handler: a.listEnvVars,
+			Tool: mcp.NewTool("apps-env-list",
+				mcp.WithDescription("List the environment variables of an app on DigitalOcean App Platform and of its components, with their type and scope. Secret values are redacted."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Component", mcp.Description("Only list the variables of this component. Lists the app-level variables and those of all components when omitted.")),
+			),
+		},
+		{
+			Handler: a.setEnvVars,
+			Tool: mcp.NewTool("apps-env-set",
+				mcp.WithDescription("Add or update environment variables of an app on DigitalOcean App Platform, at app level or for one component, and deploy the change. Only the given variables are changed; the rest of the spec is kept. Secret values are redacted in the output."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Component", mcp.Description("The component to set the variables on. Sets app-level variables, shared by all components, when omitted.")),
+				mcp.WithArray("Vars", mcp.Required(), mcp.Description("The variables to set"), mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"Key":   map[string]any{"type": "string", "description": "The variable name"},
+						"Value": map[string]any{"type": "string", "description": "The variable value"},
+						"Type":  map[string]any{"type": "string", "enum": []string{"GENERAL", "SECRET"}, "description": "GENERAL or SECRET. Defaults to the current type, or GENERAL for new variables."},
+						"Scope": map[string]any{"type": "string", "enum": []string{"RUN_TIME", "BUILD_TIME", "RUN_AND_BUILD_TIME"}, "description": "When the variable is available. Defaults to the current scope, or RUN_AND_BUILD_TIME for new variables."},
+					},
+					"required": []string{"Key", "Value"},
+				})),
+			),
+		},
+		{
+			Handler: a.unsetEnvVars,
+			Tool: mcp.NewTool("apps-env-unset",
+				mcp.WithDescription("Remove environment variables from an app on DigitalOcean App Platform, at app level or from one component, and deploy the change"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Component", mcp.Description("The component to remove the variables from. Removes app-level variables when omitted.")),
+				mcp.WithArray("Keys", mcp.Required(), mcp.Description("The names of the variables to remove"), mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+		{
+			Handler: a.listDomains,
+			Tool: mcp.NewTool("apps-domains-list",
+				mcp.WithDescription("List the custom domains of an app on DigitalOcean App Platform with their phase, certificate expiry and failed configuration steps, cross-checked against the DNS zones of the account to report the records that are missing"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+			),
+		},
+		{
+			Handler: a.addDomain,
+			Tool: mcp.NewTool("apps-domain-add",
+				mcp.WithDescription("Add a custom domain to an app on DigitalOcean App Platform and deploy the change. When the domain belongs to a DNS zone of the account, the zone is set so App Platform manages the DNS records."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Domain", mcp.Required(), mcp.Description("The domain name (e.g., app.example.com)")),
+				mcp.WithString("Type", mcp.DefaultString("ALIAS"), mcp.Enum("PRIMARY", "ALIAS"), mcp.Description("PRIMARY for the main domain of the app, ALIAS for an additional one")),
+				mcp.WithBoolean("Wildcard", mcp.DefaultBool(false), mcp.Description("Whether the domain also covers all of its subdomains")),
+				mcp.WithString("Zone", mcp.Description("The DigitalOcean DNS zone for App Platform to manage records in (e.g., example.com). Defaults to the matching zone of the account; set to an empty string to manage DNS yourself.")),
+				mcp.WithString("MinimumTLSVersion", mcp.Enum("1.2", "1.3"), mcp.Description("The minimum TLS version clients can use")),
+			),
+		},
+		{
+			Handler: a.removeDomain,
+			Tool: mcp.NewTool("apps-domain-remove",
+				mcp.WithDescription("Remove a custom domain from an app on DigitalOcean App Platform and deploy the change"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Domain", mcp.Required(), mcp.Description("The domain name to remove")),
+			),
+		},
+		{
+			Handler: a.listAlerts,
+			Tool: mcp.NewTool("apps-alerts-list",
+				mcp.WithDescription("List the alerts of an app on DigitalOcean App Platform and its components, with their rule and email and Slack destinations"),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+			),
+		},
+		{
+			Handler: a.updateAlertDestinations,
+			Tool: mcp.NewTool("apps-alert-destinations-update",
+				mcp.WithDescription("Set the destinations of an app alert on DigitalOcean App Platform. The given emails and Slack webhooks replace the current destinations."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("AlertID", mcp.Required(), mcp.Description("The ID of the alert, as returned by apps-alerts-list")),
+				mcp.WithArray("Emails", mcp.Description("Email addresses to notify. Must be verified team members."), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithArray("SlackWebhooks", mcp.Description("Slack webhooks to notify"), mcp.Items(map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"URL":     map[string]any{"type": "string", "description": "The Slack webhook URL"},
+						"Channel": map[string]any{"type": "string", "description": "The Slack channel (e.g., #alerts)"},
+					},
+					"required": []string{"URL"},
+				})),
+			),
+		},
+		{
+			Handler: a.listInstanceSizes,
+			Tool: mcp.NewTool("apps-instance-sizes-list",
+				mcp.WithDescription("List the instance sizes available to app components on DigitalOcean App Platform, cheapest first, with their CPUs, memory, monthly price and whether they support autoscaling or more than one instance. Use the slug as instance_size_slug in app specs."),
+				mcp.WithString("CPUType", mcp.Description("Only list sizes with this CPU type: SHARED or DEDICATED")),
+			),
+		},
+		{
+			Handler: a.getInstanceSize,
+			Tool: mcp.NewTool("apps-instance-size-get",
+				mcp.WithDescription("Get an App Platform instance size by its slug"),
+				mcp.WithString("Slug", mcp.Required(), mcp.Description("The instance size slug (e.g., apps-s-1vcpu-1gb)")),
+			),
+		},
+		{
+			Handler: a.listTiers,
+			Tool: mcp.NewTool("apps-tiers-list",
+				mcp.WithDescription("List the App Platform tiers with their egress bandwidth and build minute allowances"),
+			),
+		},
+		{
+			Handler: a.listRegions,
+			Tool: mcp.NewTool("apps-regions-list",
+				mcp.WithDescription("List the regions apps can be deployed to on DigitalOcean App Platform, with their data centers and whether they are disabled"),
+			),
+		},
+		{
+			Handler: a.estimateCost,
+			Tool: mcp.NewTool("apps-cost-estimate",
+				mcp.WithDescription("Estimate the monthly cost of an app spec per component and in total, from the current instance size prices. Provide exactly one of Spec, AppID or ProposalID, and compare estimates to weigh spec changes on price."),
+				mcp.WithObject("Spec", mcp.Description("An app spec to estimate")),
+				mcp.WithString("AppID", mcp.Description("The application ID of an app whose current spec to estimate")),
+				mcp.WithString("ProposalID", mcp.Description("The ID of a proposal made with apps-spec-propose whose spec to estimate")),
+			),
+		},
+		{
+			Handler: a.restartApp,
+			Tool: mcp.NewTool("apps-restart",
+				mcp.WithDescription("Restart the components of an app on DigitalOcean App Platform without rebuilding it. Returns the restart deployment."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app to restart")),
+				mcp.WithArray("Components", mcp.Description("The names of the components to restart. All components are restarted when omitted."), mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+		{
+			Handler: a.getExecURL,
+			Tool: mcp.NewTool("apps-get-exec-url",
+				mcp.WithDescription("Get the websocket URL of an interactive console on a running instance of an app component. The URL is a short-lived credential."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Component", mcp.Required(), mcp.Description("The name of the service or worker to connect to")),
+				mcp.WithString("DeploymentID", mcp.Description("The deployment to connect to. Defaults to the active deployment.")),
+				mcp.WithString("InstanceName", mcp.Description("The instance to connect to. Defaults to the first available instance.")),
+			),
+		},
+		{
+			Handler: a.runJob,
+			Tool: mcp.NewTool("apps-job-run",
+				mcp.WithDescription("Run a one-off command, such as a database migration, as a job of an app on DigitalOcean App Platform. The job is built from the same source and environment as the given component, added to the app spec and run with the deployment this triggers. The job is removed from the spec automatically once that deployment finishes."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Command", mcp.Required(), mcp.Description("The command to run (e.g., bin/rails db:migrate)")),
+				mcp.WithString("Component", mcp.Required(), mcp.Description("The service, worker or job whose source, environment and instance size the job uses")),
+				mcp.WithString("Name", mcp.DefaultString(defaultOneOffJobName), mcp.Description("The name of the job, prefixed with one-off- if it is not already. Only a one-off job of the same name is replaced; other jobs of the app are never changed.")),
+				mcp.WithString("Kind", mcp.DefaultString("PRE_DEPLOY"), mcp.Enum("PRE_DEPLOY", "POST_DEPLOY"), mcp.Description("When the job runs: PRE_DEPLOY, before the new deployment goes live and failing it if the command fails, or POST_DEPLOY, after it is live")),
+			),
+		},
+		{
+			Handler: a.removeJob,
+			Tool: mcp.NewTool("apps-job-remove",
+				mcp.WithDescription("Remove a job, such as one added with apps-job-run, from an app on DigitalOcean App Platform. This deploys the app."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("The name of the job to remove")),
+			),
+		},
+		{
+			Handler: a.detectAppSpec,
+			Tool: mcp.NewTool("apps-detect-spec",
+				mcp.WithDescription("Generate a starter app spec for DigitalOcean App Platform from a local project directory. Dockerfiles, package.json, go.mod, Python projects, Procfiles, static sites and example env files are inspected, in the directory or, for monorepos, in its subdirectories, and the source is taken from the git remote. Review the returned notes, then pass the spec to apps-create-app-from-spec."),
+				mcp.WithString("Path", mcp.Required(), mcp.Description("The local directory of the project")),
+				mcp.WithString("Name", mcp.Description("The name of the app. Defaults to the name of the directory.")),
+			),
+		},
+		{
+			Handler: a.getBandwidth,
+			Tool: mcp.NewTool("apps-bandwidth-daily",
+				mcp.WithDescription("Get the outbound bandwidth used by one or more apps on DigitalOcean App Platform on a day, in bytes and GiB"),
+				mcp.WithArray("AppIDs", mcp.Required(), mcp.Description("The IDs of the apps"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithString("Date", mcp.Description("The day, formatted as YYYY-MM-DD. Defaults to today.")),
+			),
+		},
+		{
+			Handler: a.getResourceReport,
+			Tool: mcp.NewTool("apps-resource-report",
+				mcp.WithDescription("Report the instance size, instance count, health, CPU and memory usage and monthly cost of each service and worker of an app, with today's bandwidth against the allowance of its instances. Components are flagged as unhealthy, under-provisioned or over-provisioned, with a cheaper instance size suggested when one fits the current usage."),
+				mcp.WithString("AppID", mcp.Required(), mcp.Description("The application ID of the app")),
+			),
+		},
 	}
 
 	appCreateSchema, err := loadSchema("app-create-schema.json")
 	if err != nil {
 		panic(fmt.Errorf("failed to generate app create schema: %w", err))
 	}
+	// The spec part of the schema validates the specs imported with apps-spec-import.
+	a.specSchema, err = parseAppSpecSchema(appCreateSchema)
+	if err != nil {
+		panic(err)
+	}
 
 	appCreateTool := server.ServerTool{
 		Handler: a.createAppFromAppSpec,
 		Tool: mcp.NewToolWithRawSchema(
 			"apps-create-app-from-spec",
-			"Creates an application from a given app spec. Within the app spec, a source has to be provided. The source can be a Git repository, a Dockerfile, or a container image.",
+			"Creates an application from a given app spec. Within the app spec, a source has to be provided. The source can be a Git repository, a Dockerfile, or a container image. Set project_id to assign the app to a project other than the default project.",
 			appCreateSchema,
 		),
 	}
@@ -264,7 +551,7 @@ func (a *AppPlatformTool) Tools() []server.ServerTool {
 		Handler: a.updateApp,
 		Tool: mcp.NewToolWithRawSchema(
 			"apps-update",
-			"Updates an existing application on DigitalOcean App Platform. The app ID and the AppSpec must be provided in the request.",
+			"Updates an existing application on DigitalOcean App Platform. The app ID and the AppSpec must be provided in the request, or the proposal_id of a change reviewed with apps-spec-propose to apply exactly that change.",
 			appUpdateSchema,
 		),
 	}
@@ -0,0 +1,375 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// defaultOneOffJobName is the name of the job created by apps-job-run when none is given.
+	defaultOneOffJobName = "one-off"
+	// oneOffJobPrefix starts the name of every job created by apps-job-run, so it never replaces a job of the app.
+	oneOffJobPrefix = defaultOneOffJobName + "-"
+	// jobCleanupTimeout bounds how long a one-off job is waited for before it is left for apps-job-remove.
+	jobCleanupTimeout = 2 * time.Hour
+)
+
+// jobCleanupInterval is how often the deployment running a one-off job is checked.
+var jobCleanupInterval = 15 * time.Second
+
+// jobRunResult is the outcome of adding a one-off job to an app.
+type jobRunResult struct {
+	AppID        string           `json:"app_id"`
+	Job          *godo.AppJobSpec `json:"job"`
+	DeploymentID string           `json:"deployment_id,omitempty"`
+	Removal      string           `json:"removal"`
+}
+
+// oneOffJobName returns the name of a one-off job, adding the one-off prefix when name does not have it.
+func oneOffJobName(name string) string {
+	if name == defaultOneOffJobName || strings.HasPrefix(name, oneOffJobPrefix) {
+		return name
+	}
+	return oneOffJobPrefix + name
+}
+
+// oneOffJobStore tracks the deployment running each one-off job, until the job is removed. The zero value is ready
+// to use.
+type oneOffJobStore struct {
+	mu          sync.Mutex
+	deployments map[string]string
+	cleanups    sync.WaitGroup
+}
+
+// track records the deployment running a job, replacing that of a previous run of the same job.
+func (s *oneOffJobStore) track(appID, name, deploymentID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deployments == nil {
+		s.deployments = map[string]string{}
+	}
+	s.deployments[appID+"/"+name] = deploymentID
+}
+
+// owns reports whether deploymentID is still the deployment running a job.
+func (s *oneOffJobStore) owns(appID, name, deploymentID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deployments[appID+"/"+name] == deploymentID
+}
+
+// handOver moves a job from a superseded deployment to the one replacing it, unless a later run took it over.
+func (s *oneOffJobStore) handOver(appID, name, from, to string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deployments[appID+"/"+name] != from {
+		return false
+	}
+	s.deployments[appID+"/"+name] = to
+	return true
+}
+
+// release stops tracking a job, unless a later run took it over.
+func (s *oneOffJobStore) release(appID, name, deploymentID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deployments[appID+"/"+name] == deploymentID {
+		delete(s.deployments, appID+"/"+name)
+	}
+}
+
+// deleteJob removes a job from an app spec, reporting false if the spec has no job of that name.
+func deleteJob(spec *godo.AppSpec, name string) bool {
+	kept := make([]*godo.AppJobSpec, 0, len(spec.Jobs))
+	for _, j := range spec.Jobs {
+		if j.Name != name {
+			kept = append(kept, j)
+		}
+	}
+	if len(kept) == len(spec.Jobs) {
+		return false
+	}
+	spec.Jobs = kept
+	return true
+}
+
+// removeJobAfterDeployment waits for the deployment running a one-off job to finish, successfully or not, and then
+// removes the job from the app spec so it does not run again with later deployments. When the deployment is
+// superseded, the deployment replacing it runs the job instead and is waited for. Nothing is removed if another run
+// of the job took it over in the meantime.
+func (a *AppPlatformTool) removeJobAfterDeployment(ctx context.Context, appID, name, deploymentID string) error {
+	for {
+		if !a.oneOffJobs.owns(appID, name, deploymentID) {
+			return nil
+		}
+		deployment, _, err := a.client.Apps.GetDeployment(ctx, appID, deploymentID)
+		if err != nil {
+			return err
+		}
+		switch deployment.Phase {
+		case godo.DeploymentPhase_Active, godo.DeploymentPhase_Error, godo.DeploymentPhase_Canceled:
+			return a.removeOneOffJob(ctx, appID, name, deploymentID)
+		case godo.DeploymentPhase_Superseded:
+			app, _, err := a.client.Apps.Get(ctx, appID)
+			if err != nil {
+				return err
+			}
+			next := app.InProgressDeployment
+			if next == nil {
+				next = app.PendingDeployment
+			}
+			if next == nil {
+				return a.removeOneOffJob(ctx, appID, name, deploymentID)
+			}
+			if !a.oneOffJobs.handOver(appID, name, deploymentID, next.ID) {
+				return nil
+			}
+			deploymentID = next.ID
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(jobCleanupInterval):
+		}
+	}
+}
+
+// removeOneOffJob removes a finished one-off job from the app spec.
+func (a *AppPlatformTool) removeOneOffJob(ctx context.Context, appID, name, deploymentID string) error {
+	defer a.oneOffJobs.release(appID, name, deploymentID)
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return err
+	}
+	if !deleteJob(app.Spec, name) {
+		return nil
+	}
+	_, _, err = a.client.Apps.Update(ctx, appID, &godo.AppUpdateRequest{Spec: app.Spec})
+	return err
+}
+
+// jobFromComponent builds a job spec with the source, build settings, environment and instance size of a service,
+// worker or job of the spec, so a command can run against the same code and configuration.
+func jobFromComponent(spec *godo.AppSpec, component string) (*godo.AppJobSpec, error) {
+	for _, s := range spec.Services {
+		if s.Name == component {
+			return &godo.AppJobSpec{
+				Git: s.Git, GitHub: s.GitHub, Image: s.Image, GitLab: s.GitLab, Bitbucket: s.Bitbucket,
+				DockerfilePath: s.DockerfilePath, BuildCommand: s.BuildCommand, SourceDir: s.SourceDir,
+				EnvironmentSlug: s.EnvironmentSlug, Envs: s.Envs, InstanceSizeSlug: s.InstanceSizeSlug,
+			}, nil
+		}
+	}
+	for _, w := range spec.Workers {
+		if w.Name == component {
+			return &godo.AppJobSpec{
+				Git: w.Git, GitHub: w.GitHub, Image: w.Image, GitLab: w.GitLab, Bitbucket: w.Bitbucket,
+				DockerfilePath: w.DockerfilePath, BuildCommand: w.BuildCommand, SourceDir: w.SourceDir,
+				EnvironmentSlug: w.EnvironmentSlug, Envs: w.Envs, InstanceSizeSlug: w.InstanceSizeSlug,
+			}, nil
+		}
+	}
+	for _, j := range spec.Jobs {
+		if j.Name == component {
+			return &godo.AppJobSpec{
+				Git: j.Git, GitHub: j.GitHub, Image: j.Image, GitLab: j.GitLab, Bitbucket: j.Bitbucket,
+				DockerfilePath: j.DockerfilePath, BuildCommand: j.BuildCommand, SourceDir: j.SourceDir,
+				EnvironmentSlug: j.EnvironmentSlug, Envs: j.Envs, InstanceSizeSlug: j.InstanceSizeSlug,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("app has no service, worker or job named %s", component)
+}
+
+// restartApp restarts the given components of an app, or all of them, without rebuilding it.
+func (a *AppPlatformTool) restartApp(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	restart := &godo.AppRestartRequest{Components: []string{}}
+	if raw, ok := args["Components"].([]any); ok {
+		for _, c := range raw {
+			if name, ok := c.(string); ok && name != "" {
+				restart.Components = append(restart.Components, name)
+			}
+		}
+	}
+
+	deployment, _, err := a.client.Apps.Restart(ctx, appID, restart)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	deploymentJSON, err := json.MarshalIndent(summarizeDeployment(deployment), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(deploymentJSON)), nil
+}
+
+// getExecURL gets the websocket URL of a console session on an instance of a component.
+func (a *AppPlatformTool) getExecURL(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	component, ok := args["Component"].(string)
+	if !ok || component == "" {
+		return mcp.NewToolResultError("Component is required"), nil
+	}
+	deploymentID, _ := args["DeploymentID"].(string)
+	instanceName, _ := args["InstanceName"].(string)
+
+	exec, _, err := a.client.Apps.GetExecWithOpts(ctx, appID, component, &godo.AppGetExecOptions{DeploymentID: deploymentID, InstanceName: instanceName})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	result := map[string]any{
+		"app_id":    appID,
+		"component": component,
+		"url":       exec.URL,
+		"note":      "The URL is a short-lived credential for an interactive shell on the instance; connect to it with a websocket client, or use doctl apps console.",
+	}
+	resultJSON, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(resultJSON)), nil
+}
+
+// runJob adds a job running a command to an app and deploys it, so the command runs once with the deployment.
+func (a *AppPlatformTool) runJob(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	command, ok := args["Command"].(string)
+	if !ok || strings.TrimSpace(command) == "" {
+		return mcp.NewToolResultError("Command is required"), nil
+	}
+	component, ok := args["Component"].(string)
+	if !ok || component == "" {
+		return mcp.NewToolResultError("Component is required"), nil
+	}
+	name, _ := args["Name"].(string)
+	if name == "" {
+		name = defaultOneOffJobName
+	}
+	name = oneOffJobName(name)
+	kind := godo.AppJobSpecKind_PreDeploy
+	if v, ok := args["Kind"].(string); ok && v != "" {
+		kind = godo.AppJobSpecKind(strings.ToUpper(v))
+	}
+	if kind != godo.AppJobSpecKind_PreDeploy && kind != godo.AppJobSpecKind_PostDeploy {
+		return mcp.NewToolResultError("Kind must be PRE_DEPLOY or POST_DEPLOY"), nil
+	}
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	job, err := jobFromComponent(app.Spec, component)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("invalid component", err), nil
+	}
+	job.Name = name
+	job.Kind = kind
+	job.RunCommand = command
+	job.InstanceCount = 1
+
+	// Only one-off jobs carry the one-off prefix, so a job of the same name is one from a previous run that was not
+	// removed yet and is replaced rather than duplicated.
+	replaced := false
+	for i, j := range app.Spec.Jobs {
+		if j.Name == name {
+			app.Spec.Jobs[i] = job
+			replaced = true
+		}
+	}
+	if !replaced {
+		if _, err := specEnvs(app.Spec, name); err == nil {
+			return mcp.NewToolResultError(fmt.Sprintf("app already has a component named %s; choose another job name", name)), nil
+		}
+		app.Spec.Jobs = append(app.Spec.Jobs, job)
+	}
+
+	updated, _, err := a.client.Apps.Update(ctx, appID, &godo.AppUpdateRequest{Spec: app.Spec})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	result := jobRunResult{
+		AppID: appID,
+		Job:   job,
+		Removal: fmt.Sprintf("Required: the job stays in the app spec and runs again with every deployment until it is removed. "+
+			"No deployment was started to track, so remove it with apps-job-remove (Name %s) once it has run.", name),
+	}
+	if updated.PendingDeployment != nil {
+		result.DeploymentID = updated.PendingDeployment.ID
+		result.Removal = fmt.Sprintf("The job is removed from the app spec automatically once deployment %s finishes, which starts another deployment. "+
+			"Follow it with apps-get-deployment and read its output with apps-get-logs. "+
+			"If the job is still in the spec afterwards, remove it with apps-job-remove (Name %s).", result.DeploymentID, name)
+		a.oneOffJobs.track(appID, name, result.DeploymentID)
+		a.oneOffJobs.cleanups.Add(1)
+		go func(deploymentID string) {
+			defer a.oneOffJobs.cleanups.Done()
+			// The request context ends with the tool call, long before the deployment finishes.
+			cleanupCtx, cancel := context.WithTimeout(context.Background(), jobCleanupTimeout)
+			defer cancel()
+			if err := a.removeJobAfterDeployment(cleanupCtx, appID, name, deploymentID); err != nil {
+				slog.Warn("failed to remove one-off job", "app_id", appID, "job", name, "deployment_id", deploymentID, "error", err)
+			}
+		}(result.DeploymentID)
+	}
+	resultJSON, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(resultJSON)), nil
+}
+
+// removeJob removes a job from an app.
+func (a *AppPlatformTool) removeJob(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Job name is required"), nil
+	}
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if !deleteJob(app.Spec, name) {
+		return mcp.NewToolResultError(fmt.Sprintf("app %s has no job named %s", appID, name)), nil
+	}
+
+	updated, _, err := a.client.Apps.Update(ctx, appID, &godo.AppUpdateRequest{Spec: app.Spec})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	result := map[string]any{"app_id": appID, "removed": name}
+	if updated.PendingDeployment != nil {
+		result["deployment_id"] = updated.PendingDeployment.ID
+	}
+	resultJSON, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(resultJSON)), nil
+}
@@ -0,0 +1,269 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRestartApp(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mock        func(app *MockAppsService)
+		expectError bool
+	}{
+		{
+			name: "Restart a component",
+			args: map[string]any{"AppID": "app-123", "Components": []any{"web"}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Restart(gomock.Any(), "app-123", &godo.AppRestartRequest{Components: []string{"web"}}).
+					Return(&godo.Deployment{ID: "deploy-1", Phase: godo.DeploymentPhase_PendingDeploy}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Restart all components",
+			args: map[string]any{"AppID": "app-123"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Restart(gomock.Any(), "app-123", &godo.AppRestartRequest{Components: []string{}}).
+					Return(&godo.Deployment{ID: "deploy-1"}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"AppID": "app-123", "Components": []any{"docs"}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Restart(gomock.Any(), "app-123", gomock.Any()).Return(nil, nil, fmt.Errorf("component docs cannot be restarted")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing AppID",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			resp, err := tool.restartApp(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
+
+func TestGetExecURL(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().GetExecWithOpts(gomock.Any(), "app-123", "web", &godo.AppGetExecOptions{InstanceName: "web-abc"}).
+		Return(&godo.AppExec{URL: "wss://exec.ondigitalocean.app/?token=xyz"}, nil, nil).Times(1)
+
+	resp, err := tool.getExecURL(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"AppID": "app-123", "Component": "web", "InstanceName": "web-abc",
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "wss://exec.ondigitalocean.app/?token=xyz")
+
+	resp, err = tool.getExecURL(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestRunJob(t *testing.T) {
+	interval := jobCleanupInterval
+	jobCleanupInterval = time.Millisecond
+	defer func() { jobCleanupInterval = interval }()
+
+	migrate := func(kind godo.AppJobSpecKind) *godo.AppJobSpec {
+		web := testAppSpec().Services[0]
+		return &godo.AppJobSpec{
+			Name:             "one-off-migrate",
+			Envs:             web.Envs,
+			InstanceSizeSlug: web.InstanceSizeSlug,
+			InstanceCount:    1,
+			Kind:             kind,
+			RunCommand:       "bin/rails db:migrate",
+		}
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		spec        func() *godo.AppSpec
+		expected    func() *godo.AppSpec
+		expectError bool
+	}{
+		{
+			name: "Add a pre-deploy job",
+			args: map[string]any{"AppID": "app-123", "Command": "bin/rails db:migrate", "Component": "web", "Name": "migrate"},
+			spec: testAppSpec,
+			expected: func() *godo.AppSpec {
+				spec := testAppSpec()
+				spec.Jobs = []*godo.AppJobSpec{migrate(godo.AppJobSpecKind_PreDeploy)}
+				return spec
+			},
+		},
+		{
+			name: "Replace the job of a previous run",
+			args: map[string]any{"AppID": "app-123", "Command": "bin/rails db:migrate", "Component": "web", "Name": "one-off-migrate", "Kind": "post_deploy"},
+			spec: func() *godo.AppSpec {
+				spec := testAppSpec()
+				spec.Jobs = []*godo.AppJobSpec{{Name: "one-off-migrate", RunCommand: "bin/rails db:seed"}}
+				return spec
+			},
+			expected: func() *godo.AppSpec {
+				spec := testAppSpec()
+				spec.Jobs = []*godo.AppJobSpec{migrate(godo.AppJobSpecKind_PostDeploy)}
+				return spec
+			},
+		},
+		{
+			name: "Keep a job of the app with the same name",
+			args: map[string]any{"AppID": "app-123", "Command": "bin/rails db:migrate", "Component": "web", "Name": "migrate"},
+			spec: func() *godo.AppSpec {
+				spec := testAppSpec()
+				spec.Jobs = []*godo.AppJobSpec{{Name: "migrate", RunCommand: "bin/rails db:seed", Kind: godo.AppJobSpecKind_PreDeploy}}
+				return spec
+			},
+			expected: func() *godo.AppSpec {
+				spec := testAppSpec()
+				spec.Jobs = []*godo.AppJobSpec{
+					{Name: "migrate", RunCommand: "bin/rails db:seed", Kind: godo.AppJobSpecKind_PreDeploy},
+					migrate(godo.AppJobSpecKind_PreDeploy),
+				}
+				return spec
+			},
+		},
+		{
+			name:        "Name of another component",
+			args:        map[string]any{"AppID": "app-123", "Command": "true", "Component": "web", "Name": "one-off"},
+			spec:        func() *godo.AppSpec { spec := testAppSpec(); spec.Services[1].Name = "one-off"; return spec },
+			expectError: true,
+		},
+		{
+			name:        "Unknown component",
+			args:        map[string]any{"AppID": "app-123", "Command": "true", "Component": "db"},
+			spec:        testAppSpec,
+			expectError: true,
+		},
+		{
+			name:        "Invalid kind",
+			args:        map[string]any{"AppID": "app-123", "Command": "true", "Component": "web", "Kind": "FAILED_DEPLOY"},
+			expectError: true,
+		},
+		{
+			name:        "Missing command",
+			args:        map[string]any{"AppID": "app-123", "Component": "web"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.spec != nil {
+				appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: tc.spec()}, nil, nil).Times(1)
+			}
+			if tc.expected != nil {
+				appService.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: tc.expected()}).
+					Return(&godo.App{ID: "app-123", PendingDeployment: &godo.Deployment{ID: "deploy-2"}}, nil, nil).Times(1)
+
+				// Once the deployment finishes, the one-off job is removed and the other jobs are kept.
+				appService.EXPECT().GetDeployment(gomock.Any(), "app-123", "deploy-2").
+					Return(&godo.Deployment{ID: "deploy-2", Phase: godo.DeploymentPhase_Active}, nil, nil).Times(1)
+				appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: tc.expected()}, nil, nil).Times(1)
+				cleaned := tc.expected()
+				cleaned.Jobs = cleaned.Jobs[:len(cleaned.Jobs)-1]
+				appService.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: cleaned}).
+					Return(&godo.App{ID: "app-123"}, nil, nil).Times(1)
+			}
+			resp, err := tool.runJob(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			tool.oneOffJobs.cleanups.Wait()
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var result jobRunResult
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+			require.Equal(t, "deploy-2", result.DeploymentID)
+			require.Equal(t, "one-off-migrate", result.Job.Name)
+			require.Contains(t, result.Removal, "removed from the app spec automatically")
+		})
+	}
+}
+
+func TestRemoveJobAfterDeployment(t *testing.T) {
+	interval := jobCleanupInterval
+	jobCleanupInterval = time.Millisecond
+	defer func() { jobCleanupInterval = interval }()
+
+	withJob := func() *godo.AppSpec {
+		spec := testAppSpec()
+		spec.Jobs = []*godo.AppJobSpec{{Name: "one-off", RunCommand: "bin/rails db:migrate"}}
+		return spec
+	}
+
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	tool.oneOffJobs.track("app-123", "one-off", "deploy-2")
+	gomock.InOrder(
+		appService.EXPECT().GetDeployment(gomock.Any(), "app-123", "deploy-2").
+			Return(&godo.Deployment{ID: "deploy-2", Phase: godo.DeploymentPhase_Building}, nil, nil),
+		appService.EXPECT().GetDeployment(gomock.Any(), "app-123", "deploy-2").
+			Return(&godo.Deployment{ID: "deploy-2", Phase: godo.DeploymentPhase_Superseded}, nil, nil),
+		appService.EXPECT().Get(gomock.Any(), "app-123").
+			Return(&godo.App{ID: "app-123", Spec: withJob(), InProgressDeployment: &godo.Deployment{ID: "deploy-3"}}, nil, nil),
+		appService.EXPECT().GetDeployment(gomock.Any(), "app-123", "deploy-3").
+			Return(&godo.Deployment{ID: "deploy-3", Phase: godo.DeploymentPhase_Error}, nil, nil),
+		appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: withJob()}, nil, nil),
+		appService.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: func() *godo.AppSpec {
+			spec := testAppSpec()
+			spec.Jobs = []*godo.AppJobSpec{}
+			return spec
+		}()}).Return(&godo.App{ID: "app-123"}, nil, nil),
+	)
+	require.NoError(t, tool.removeJobAfterDeployment(context.Background(), "app-123", "one-off", "deploy-2"))
+	require.False(t, tool.oneOffJobs.owns("app-123", "one-off", "deploy-3"))
+
+	// A later run took the job over, so it is left for that run to remove.
+	tool.oneOffJobs.track("app-123", "one-off", "deploy-5")
+	require.NoError(t, tool.removeJobAfterDeployment(context.Background(), "app-123", "one-off", "deploy-4"))
+	require.True(t, tool.oneOffJobs.owns("app-123", "one-off", "deploy-5"))
+}
+
+func TestRemoveJob(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	spec := testAppSpec()
+	spec.Jobs = []*godo.AppJobSpec{{Name: "migrate"}}
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: spec}, nil, nil).Times(1)
+	expected := testAppSpec()
+	expected.Jobs = []*godo.AppJobSpec{}
+	appService.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: expected}).Return(&godo.App{ID: "app-123"}, nil, nil).Times(1)
+
+	resp, err := tool.removeJob(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Name": "migrate"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+	resp, err = tool.removeJob(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Name": "migrate"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -0,0 +1,277 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// deploymentStep is a flattened deployment progress step.
+type deploymentStep struct {
+	Name      string     `json:"name"`
+	Component string     `json:"component,omitempty"`
+	Status    string     `json:"status"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+}
+
+// deploymentSummary is a condensed view of a deployment for the deployment history.
+type deploymentSummary struct {
+	ID                   string           `json:"id"`
+	Phase                string           `json:"phase"`
+	Cause                string           `json:"cause,omitempty"`
+	CauseType            string           `json:"cause_type,omitempty"`
+	PreviousDeploymentID string           `json:"previous_deployment_id,omitempty"`
+	CreatedAt            time.Time        `json:"created_at"`
+	UpdatedAt            time.Time        `json:"updated_at"`
+	Progress             string           `json:"progress,omitempty"`
+	Steps                []deploymentStep `json:"steps,omitempty"`
+	FailedSteps          []deploymentStep `json:"failed_steps,omitempty"`
+}
+
+// deploymentDetail is a single deployment with its flattened progress steps.
+type deploymentDetail struct {
+	Summary    deploymentSummary `json:"summary"`
+	Deployment *godo.Deployment  `json:"deployment"`
+}
+
+// appRollbackRequest is the request body of the rollback endpoints.
+type appRollbackRequest struct {
+	DeploymentID string `json:"deployment_id"`
+	SkipPin      bool   `json:"skip_pin,omitempty"`
+}
+
+// appRollbackCondition is a condition reported by the rollback validation.
+type appRollbackCondition struct {
+	Code       string   `json:"code"`
+	Message    string   `json:"message"`
+	Components []string `json:"components,omitempty"`
+}
+
+// appRollbackValidation is the response of the rollback validation endpoint.
+type appRollbackValidation struct {
+	Valid    bool                    `json:"valid"`
+	Error    *appRollbackCondition   `json:"error,omitempty"`
+	Warnings []*appRollbackCondition `json:"warnings,omitempty"`
+}
+
+// deploymentRoot wraps the deployment returned by the rollback endpoints.
+type deploymentRoot struct {
+	Deployment *godo.Deployment `json:"deployment"`
+}
+
+// flattenSteps flattens nested progress steps, naming sub-steps after their parents.
+func flattenSteps(prefix string, steps []*godo.DeploymentProgressStep) []deploymentStep {
+	var flat []deploymentStep
+	for _, s := range steps {
+		if s == nil {
+			continue
+		}
+		name := s.Name
+		if prefix != "" {
+			name = prefix + "/" + name
+		}
+		step := deploymentStep{Name: name, Component: s.ComponentName, Status: string(s.Status)}
+		if !s.StartedAt.IsZero() {
+			startedAt := s.StartedAt
+			step.StartedAt = &startedAt
+		}
+		if !s.EndedAt.IsZero() {
+			endedAt := s.EndedAt
+			step.EndedAt = &endedAt
+		}
+		if s.Reason != nil {
+			step.Reason = strings.TrimSpace(s.Reason.Code + ": " + s.Reason.Message)
+		}
+		flat = append(flat, step)
+		flat = append(flat, flattenSteps(name, s.Steps)...)
+	}
+	return flat
+}
+
+// summarizeDeployment condenses a deployment, keeping the summary steps and the steps that failed.
+func summarizeDeployment(d *godo.Deployment) deploymentSummary {
+	summary := deploymentSummary{
+		ID:                   d.ID,
+		Phase:                string(d.Phase),
+		Cause:                d.Cause,
+		PreviousDeploymentID: d.PreviousDeploymentID,
+		CreatedAt:            d.CreatedAt,
+		UpdatedAt:            d.UpdatedAt,
+	}
+	if d.CauseDetails != nil {
+		summary.CauseType = string(d.CauseDetails.Type)
+	}
+	if p := d.Progress; p != nil {
+		summary.Progress = fmt.Sprintf("%d/%d steps succeeded, %d running, %d pending, %d failed",
+			p.SuccessSteps, p.TotalSteps, p.RunningSteps, p.PendingSteps, p.ErrorSteps)
+		summary.Steps = flattenSteps("", p.SummarySteps)
+		for _, step := range flattenSteps("", p.Steps) {
+			if step.Status == string(godo.DeploymentProgressStepStatus_Error) {
+				summary.FailedSteps = append(summary.FailedSteps, step)
+			}
+		}
+	}
+	return summary
+}
+
+// listDeployments lists the deployment history of an app, most recent first.
+func (a *AppPlatformTool) listDeployments(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	page, ok := args["Page"].(float64)
+	if !ok {
+		page = defaultPage
+	}
+	perPage, ok := args["PerPage"].(float64)
+	if !ok {
+		perPage = defaultPageSize
+	}
+
+	deployments, _, err := a.client.Apps.ListDeployments(ctx, appID, &godo.ListOptions{Page: int(page), PerPage: int(perPage)})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	summaries := make([]deploymentSummary, 0, len(deployments))
+	for _, d := range deployments {
+		summaries = append(summaries, summarizeDeployment(d))
+	}
+	summariesJSON, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(summariesJSON)), nil
+}
+
+// getDeployment retrieves a single deployment of an app with its progress steps.
+func (a *AppPlatformTool) getDeployment(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	deploymentID, ok := args["DeploymentID"].(string)
+	if !ok || deploymentID == "" {
+		return mcp.NewToolResultError("Deployment ID is required"), nil
+	}
+
+	deployment, _, err := a.client.Apps.GetDeployment(ctx, appID, deploymentID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	detail := deploymentDetail{Summary: summarizeDeployment(deployment), Deployment: deployment}
+	// The summary only keeps the failed steps; the detail shows all of them.
+	if deployment.Progress != nil {
+		detail.Summary.Steps = flattenSteps("", deployment.Progress.Steps)
+	}
+	detailJSON, err := json.MarshalIndent(detail, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(detailJSON)), nil
+}
+
+// doAppsRequest sends a request to the apps API. The rollback and bandwidth endpoints have no typed methods in
+// godo yet, so they are called through the client directly, which still applies its authentication, rate limiting
+// and error handling.
+func (a *AppPlatformTool) doAppsRequest(ctx context.Context, method, path string, body, v any) error {
+	httpReq, err := a.client.NewRequest(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	_, err = a.client.Do(ctx, httpReq, v)
+	return err
+}
+
+// rollbackArgs reads the app ID, deployment ID and pin flag of the rollback tools.
+func rollbackArgs(args map[string]any) (string, *appRollbackRequest, *mcp.CallToolResult) {
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return "", nil, mcp.NewToolResultError("App ID is required")
+	}
+	deploymentID, ok := args["DeploymentID"].(string)
+	if !ok || deploymentID == "" {
+		return "", nil, mcp.NewToolResultError("Deployment ID is required")
+	}
+	skipPin, _ := args["SkipPin"].(bool)
+	return appID, &appRollbackRequest{DeploymentID: deploymentID, SkipPin: skipPin}, nil
+}
+
+// validateRollback checks whether an app can be rolled back to a previous deployment.
+func (a *AppPlatformTool) validateRollback(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, rollback, errResult := rollbackArgs(req.GetArguments())
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	validation := &appRollbackValidation{}
+	if err := a.doAppsRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/apps/%s/rollback/validate", appID), rollback, validation); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	validationJSON, err := json.MarshalIndent(validation, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(validationJSON)), nil
+}
+
+// rollbackApp rolls an app back to a previous deployment.
+func (a *AppPlatformTool) rollbackApp(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, rollback, errResult := rollbackArgs(req.GetArguments())
+	if errResult != nil {
+		return errResult, nil
+	}
+
+	root := &deploymentRoot{}
+	if err := a.doAppsRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/apps/%s/rollback", appID), rollback, root); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	deploymentJSON, err := json.MarshalIndent(root.Deployment, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(deploymentJSON)), nil
+}
+
+// commitRollback commits the pending rollback of an app, unpinning it so new deployments are allowed again.
+func (a *AppPlatformTool) commitRollback(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, ok := req.GetArguments()["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+
+	if err := a.doAppsRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/apps/%s/rollback/commit", appID), nil, nil); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Rollback committed successfully"), nil
+}
+
+// revertRollback reverts the pending rollback of an app, redeploying the deployment that was active before it.
+func (a *AppPlatformTool) revertRollback(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, ok := req.GetArguments()["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+
+	root := &deploymentRoot{}
+	if err := a.doAppsRequest(ctx, http.MethodPost, fmt.Sprintf("/v2/apps/%s/rollback/revert", appID), nil, root); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	deploymentJSON, err := json.MarshalIndent(root.Deployment, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(deploymentJSON)), nil
+}
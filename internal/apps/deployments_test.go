@@ -0,0 +1,196 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var failedDeployment = &godo.Deployment{
+	ID:                   "deploy-2",
+	Phase:                godo.DeploymentPhase_Error,
+	Cause:                "commit 9a4df0b pushed to github.com/example/app/tree/main",
+	PreviousDeploymentID: "deploy-1",
+	CauseDetails:         &godo.DeploymentCauseDetails{Type: godo.DeploymentCauseDetailsType_DeployOnPush},
+	Progress: &godo.DeploymentProgress{
+		SuccessSteps: 1,
+		ErrorSteps:   1,
+		TotalSteps:   2,
+		SummarySteps: []*godo.DeploymentProgressStep{{Name: "build", Status: godo.DeploymentProgressStepStatus_Error}},
+		Steps: []*godo.DeploymentProgressStep{
+			{
+				Name:   "build",
+				Status: godo.DeploymentProgressStepStatus_Error,
+				Steps: []*godo.DeploymentProgressStep{
+					{Name: "initialize", Status: godo.DeploymentProgressStepStatus_Success},
+					{
+						Name:          "components",
+						ComponentName: "web",
+						Status:        godo.DeploymentProgressStepStatus_Error,
+						Reason:        &godo.DeploymentProgressStepReason{Code: "BuildJobFailed", Message: "Your build job failed"},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestSummarizeDeployment(t *testing.T) {
+	summary := summarizeDeployment(failedDeployment)
+
+	require.Equal(t, "DEPLOY_ON_PUSH", summary.CauseType)
+	require.Equal(t, "1/2 steps succeeded, 0 running, 0 pending, 1 failed", summary.Progress)
+	require.Equal(t, []deploymentStep{{Name: "build", Status: "ERROR"}}, summary.Steps)
+	require.Equal(t, []deploymentStep{
+		{Name: "build", Status: "ERROR"},
+		{Name: "build/components", Component: "web", Status: "ERROR", Reason: "BuildJobFailed: Your build job failed"},
+	}, summary.FailedSteps)
+}
+
+func TestListDeployments(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().ListDeployments(gomock.Any(), "app-123", &godo.ListOptions{Page: 1, PerPage: 2}).
+		Return([]*godo.Deployment{failedDeployment, {ID: "deploy-1", Phase: godo.DeploymentPhase_Active}}, nil, nil).Times(1)
+	appService.EXPECT().ListDeployments(gomock.Any(), "app-404", gomock.Any()).
+		Return(nil, nil, fmt.Errorf("not found")).Times(1)
+
+	resp, err := tool.listDeployments(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"AppID":   "app-123",
+		"PerPage": float64(2),
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var summaries []deploymentSummary
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &summaries))
+	require.Len(t, summaries, 2)
+	require.Equal(t, "ERROR", summaries[0].Phase)
+	require.Len(t, summaries[0].FailedSteps, 2)
+	require.Equal(t, "ACTIVE", summaries[1].Phase)
+
+	resp, err = tool.listDeployments(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-404"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestGetDeployment(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().GetDeployment(gomock.Any(), "app-123", "deploy-2").Return(failedDeployment, nil, nil).Times(1)
+
+	resp, err := tool.getDeployment(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"AppID":        "app-123",
+		"DeploymentID": "deploy-2",
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var detail deploymentDetail
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &detail))
+	require.Len(t, detail.Summary.Steps, 3)
+	require.Equal(t, "deploy-2", detail.Deployment.ID)
+
+	resp, err = tool.getDeployment(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestRollback(t *testing.T) {
+	var requests []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body)))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/apps/app-123/rollback/validate":
+			fmt.Fprint(w, `{"valid": false, "error": {"code": "incompatible_result", "message": "the deployment is too old"}}`)
+		case "/v2/apps/app-123/rollback", "/v2/apps/app-123/rollback/revert":
+			fmt.Fprint(w, `{"deployment": {"id": "deploy-3", "phase": "PENDING_DEPLOY"}}`)
+		case "/v2/apps/app-123/rollback/commit":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id": "not_found", "message": "app not found"}`)
+		}
+	}))
+	defer apiServer.Close()
+
+	client, err := godo.New(apiServer.Client(), godo.SetBaseURL(apiServer.URL+"/"))
+	require.NoError(t, err)
+	tool := &AppPlatformTool{client: client}
+	rollbackArgs := map[string]any{"AppID": "app-123", "DeploymentID": "deploy-1", "SkipPin": true}
+
+	tests := []struct {
+		name            string
+		handler         func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error)
+		args            map[string]any
+		expectError     bool
+		expectedText    string
+		expectedRequest string
+	}{
+		{
+			name:            "Validate rollback",
+			handler:         tool.validateRollback,
+			args:            rollbackArgs,
+			expectedText:    "the deployment is too old",
+			expectedRequest: `POST /v2/apps/app-123/rollback/validate {"deployment_id":"deploy-1","skip_pin":true}`,
+		},
+		{
+			name:            "Rollback",
+			handler:         tool.rollbackApp,
+			args:            rollbackArgs,
+			expectedText:    "deploy-3",
+			expectedRequest: `POST /v2/apps/app-123/rollback {"deployment_id":"deploy-1","skip_pin":true}`,
+		},
+		{
+			name:            "Commit rollback",
+			handler:         tool.commitRollback,
+			args:            map[string]any{"AppID": "app-123"},
+			expectedText:    "Rollback committed successfully",
+			expectedRequest: "POST /v2/apps/app-123/rollback/commit",
+		},
+		{
+			name:            "Revert rollback",
+			handler:         tool.revertRollback,
+			args:            map[string]any{"AppID": "app-123"},
+			expectedText:    "deploy-3",
+			expectedRequest: "POST /v2/apps/app-123/rollback/revert",
+		},
+		{
+			name:        "API error",
+			handler:     tool.rollbackApp,
+			args:        map[string]any{"AppID": "app-404", "DeploymentID": "deploy-1"},
+			expectError: true,
+		},
+		{
+			name:        "Missing deployment ID",
+			handler:     tool.validateRollback,
+			args:        map[string]any{"AppID": "app-123"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requests = nil
+			resp, err := tc.handler(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			require.Contains(t, resp.Content[0].(mcp.TextContent).Text, tc.expectedText)
+			require.Equal(t, []string{tc.expectedRequest}, requests)
+		})
+	}
+}
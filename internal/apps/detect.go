@@ -0,0 +1,556 @@
+package apps
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// defaultHTTPPort is the port App Platform routes traffic to, and sets as $PORT, unless configured otherwise.
+	defaultHTTPPort = 8080
+	// envPlaceholder is the value given to environment variables that have to be filled in before deploying.
+	envPlaceholder = "CHANGE_ME"
+	// maxHealthScanFiles bounds the number of source files searched for a health check route.
+	maxHealthScanFiles = 500
+	// maxHealthScanSize is the size above which source files are not searched for a health check route.
+	maxHealthScanSize = 256 << 10
+)
+
+var (
+	exposeRe      = regexp.MustCompile(`(?im)^\s*EXPOSE\s+(\d+)`)
+	componentName = regexp.MustCompile(`[^a-z0-9]+`)
+	secretKeyRe   = regexp.MustCompile(`(?i)(SECRET|PASSWORD|PASSWD|TOKEN|API_?KEY|PRIVATE_?KEY|CREDENTIALS?)`)
+	// healthPaths are the health check routes looked for in the source, most specific first.
+	healthPaths = []string{"/healthz", "/health", "/healthcheck", "/api/health", "/_health", "/ping"}
+	// healthScanExts are the extensions of the source files searched for a health check route.
+	healthScanExts = map[string]bool{".go": true, ".js": true, ".mjs": true, ".cjs": true, ".ts": true, ".py": true}
+	// skippedDirs are directories that never hold app components or hand-written sources.
+	skippedDirs = map[string]bool{"node_modules": true, "vendor": true, "dist": true, "build": true, "venv": true, ".venv": true, "__pycache__": true}
+	// envExampleFiles are the files listing the environment variables of a project, in order of preference.
+	envExampleFiles = []string{".env.example", ".env.sample", ".env.template", "example.env"}
+)
+
+// staticFrameworks maps the packages of static site generators to the directory their build writes to.
+var staticFrameworks = []struct {
+	pkg       string
+	outputDir string
+}{
+	{"@docusaurus/core", "build"},
+	{"gatsby", "public"},
+	{"react-scripts", "build"},
+	{"@vue/cli-service", "dist"},
+	{"vite", "dist"},
+}
+
+// detectedSpec is a starter app spec generated from a local directory.
+type detectedSpec struct {
+	Spec     *godo.AppSpec `json:"spec"`
+	Detected []string      `json:"detected"`
+	Notes    []string      `json:"notes,omitempty"`
+}
+
+// packageJSON holds the parts of a package.json file that matter for detection.
+type packageJSON struct {
+	Scripts         map[string]string `json:"scripts"`
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+}
+
+// hasPackage reports whether the package is a dependency or dev dependency.
+func (p *packageJSON) hasPackage(name string) bool {
+	_, dep := p.Dependencies[name]
+	_, dev := p.DevDependencies[name]
+	return dep || dev
+}
+
+// componentSource is the repository that every detected component is built from.
+type componentSource struct {
+	GitHub *godo.GitHubSourceSpec
+	GitLab *godo.GitLabSourceSpec
+	Git    *godo.GitSourceSpec
+}
+
+// specDetector accumulates the components, findings and notes of a detection.
+type specDetector struct {
+	root     string
+	source   componentSource
+	spec     *godo.AppSpec
+	detected []string
+	notes    []string
+}
+
+// sanitizeComponentName turns a directory or process name into a valid app or component name.
+func sanitizeComponentName(name string) string {
+	name = strings.Trim(componentName.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if name == "" || name[0] < 'a' || name[0] > 'z' {
+		name = "app-" + name
+	}
+	if len(name) > 32 {
+		name = name[:32]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// fileExists reports whether a regular file exists.
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+// parseProcfile returns the process types of a Procfile and their commands, in file order.
+func parseProcfile(path string) ([][2]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var processes [][2]string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		process, command, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		processes = append(processes, [2]string{strings.TrimSpace(process), strings.TrimSpace(command)})
+	}
+	return processes, scanner.Err()
+}
+
+// parseEnvExample reads the variables of an example env file as placeholders. Values that look like secrets are
+// never copied, and empty or secret values are replaced by envPlaceholder.
+func parseEnvExample(path string) ([]*godo.AppVariableDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var envs []*godo.AppVariableDefinition
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), `"'`)
+		if key == "" || key == "PORT" {
+			continue
+		}
+		env := &godo.AppVariableDefinition{Key: key, Value: value, Scope: godo.AppVariableScope_RunAndBuildTime}
+		if secretKeyRe.MatchString(key) {
+			env.Type = godo.AppVariableType_Secret
+			env.Value = envPlaceholder
+		}
+		if env.Value == "" {
+			env.Value = envPlaceholder
+		}
+		envs = append(envs, env)
+	}
+	return envs, scanner.Err()
+}
+
+// detectGitSource reads the origin remote and current branch of a git checkout.
+func detectGitSource(root string) (componentSource, bool) {
+	config, err := os.ReadFile(filepath.Join(root, ".git", "config"))
+	if err != nil {
+		return componentSource{}, false
+	}
+	var remoteURL string
+	inOrigin := false
+	scanner := bufio.NewScanner(bytes.NewReader(config))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "[") {
+			inOrigin = line == `[remote "origin"]`
+			continue
+		}
+		if key, value, ok := strings.Cut(line, "="); ok && inOrigin && strings.TrimSpace(key) == "url" {
+			remoteURL = strings.TrimSpace(value)
+		}
+	}
+	if remoteURL == "" {
+		return componentSource{}, false
+	}
+	branch := ""
+	if head, err := os.ReadFile(filepath.Join(root, ".git", "HEAD")); err == nil {
+		branch = strings.TrimPrefix(strings.TrimSpace(string(head)), "ref: refs/heads/")
+		if strings.HasPrefix(branch, "ref:") || len(branch) == 40 && !strings.Contains(branch, "/") {
+			branch = ""
+		}
+	}
+
+	for _, host := range []string{"github.com", "gitlab.com"} {
+		repo := ""
+		switch {
+		case strings.HasPrefix(remoteURL, "git@"+host+":"):
+			repo = strings.TrimPrefix(remoteURL, "git@"+host+":")
+		case strings.HasPrefix(remoteURL, "https://"+host+"/"):
+			repo = strings.TrimPrefix(remoteURL, "https://"+host+"/")
+		case strings.HasPrefix(remoteURL, "ssh://git@"+host+"/"):
+			repo = strings.TrimPrefix(remoteURL, "ssh://git@"+host+"/")
+		default:
+			continue
+		}
+		repo = strings.TrimSuffix(strings.TrimSuffix(repo, "/"), ".git")
+		if host == "github.com" {
+			return componentSource{GitHub: &godo.GitHubSourceSpec{Repo: repo, Branch: branch, DeployOnPush: true}}, true
+		}
+		return componentSource{GitLab: &godo.GitLabSourceSpec{Repo: repo, Branch: branch, DeployOnPush: true}}, true
+	}
+	return componentSource{Git: &godo.GitSourceSpec{RepoCloneURL: remoteURL, Branch: branch}}, true
+}
+
+// findHealthPath searches the source files of a directory for a known health check route.
+func findHealthPath(dir string) string {
+	found := make(map[string]bool)
+	scanned := 0
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if path != dir && (strings.HasPrefix(d.Name(), ".") || skippedDirs[d.Name()]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !healthScanExts[filepath.Ext(path)] || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		if info, err := d.Info(); err != nil || info.Size() > maxHealthScanSize {
+			return nil
+		}
+		if scanned++; scanned > maxHealthScanFiles {
+			return filepath.SkipAll
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, p := range healthPaths {
+			for _, quote := range []string{`"`, `'`, "`"} {
+				if bytes.Contains(data, []byte(quote+p+quote)) {
+					found[p] = true
+				}
+			}
+		}
+		return nil
+	})
+	for _, p := range healthPaths {
+		if found[p] {
+			return p
+		}
+	}
+	return ""
+}
+
+// dockerfilePort returns the first port exposed by a Dockerfile.
+func dockerfilePort(path string) int64 {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	m := exposeRe.FindSubmatch(data)
+	if m == nil {
+		return 0
+	}
+	port, err := strconv.ParseInt(string(m[1]), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return port
+}
+
+// pythonRunCommand guesses the command starting a Python web app that has no Procfile.
+func pythonRunCommand(dir string, requirements string) string {
+	requirements = strings.ToLower(requirements)
+	if fileExists(filepath.Join(dir, "manage.py")) && strings.Contains(requirements, "django") {
+		if matches, _ := filepath.Glob(filepath.Join(dir, "*", "wsgi.py")); len(matches) > 0 {
+			project := filepath.Base(filepath.Dir(matches[0]))
+			return "gunicorn --worker-tmp-dir /dev/shm " + project + ".wsgi"
+		}
+	}
+	for _, module := range []string{"app", "main", "wsgi"} {
+		if !fileExists(filepath.Join(dir, module+".py")) {
+			continue
+		}
+		if strings.Contains(requirements, "uvicorn") {
+			return fmt.Sprintf("uvicorn %s:app --host 0.0.0.0 --port %d", module, defaultHTTPPort)
+		}
+		if strings.Contains(requirements, "gunicorn") {
+			return "gunicorn --worker-tmp-dir /dev/shm " + module + ":app"
+		}
+	}
+	return ""
+}
+
+// relPath returns the path of a file relative to the repository root, as used by source_dir and dockerfile_path.
+func (d *specDetector) relPath(path string) string {
+	rel, err := filepath.Rel(d.root, path)
+	if err != nil || rel == "." {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
+
+// componentEnvs returns the placeholders of the example env file of a directory.
+func (d *specDetector) componentEnvs(dir, name string) []*godo.AppVariableDefinition {
+	for _, file := range envExampleFiles {
+		path := filepath.Join(dir, file)
+		if !fileExists(path) {
+			continue
+		}
+		envs, err := parseEnvExample(path)
+		if err != nil {
+			d.notes = append(d.notes, fmt.Sprintf("Could not read %s: %v", d.relPath(path), err))
+			return nil
+		}
+		var placeholders []string
+		for _, env := range envs {
+			if env.Value == envPlaceholder {
+				placeholders = append(placeholders, env.Key)
+			}
+		}
+		d.detected = append(d.detected, fmt.Sprintf("%s: environment variables from %s", name, d.relPath(path)))
+		if len(placeholders) > 0 {
+			d.notes = append(d.notes, fmt.Sprintf("Set the values of %s on %s; they are %s placeholders.", strings.Join(placeholders, ", "), name, envPlaceholder))
+		}
+		return envs
+	}
+	return nil
+}
+
+// addService adds a service listening on the default port, with a health check when a route was found.
+func (d *specDetector) addService(dir string, service *godo.AppServiceSpec) {
+	service.GitHub, service.GitLab, service.Git = d.source.GitHub, d.source.GitLab, d.source.Git
+	service.SourceDir = d.relPath(dir)
+	service.InstanceCount = 1
+	service.InstanceSizeSlug = defaultInstanceSizeSlug
+	if service.HTTPPort == 0 {
+		service.HTTPPort = defaultHTTPPort
+	}
+	if path := findHealthPath(dir); path != "" {
+		service.HealthCheck = &godo.AppServiceSpecHealthCheck{HTTPPath: path}
+		d.detected = append(d.detected, fmt.Sprintf("%s: health check route %s", service.Name, path))
+	}
+	service.Envs = d.componentEnvs(dir, service.Name)
+	d.spec.Services = append(d.spec.Services, service)
+}
+
+// addProcesses adds the non-web processes of a Procfile as workers, and its release process as a pre-deploy job.
+func (d *specDetector) addProcesses(dir, name, environment string, processes [][2]string) {
+	for _, p := range processes {
+		switch p[0] {
+		case "web":
+			continue
+		case "release":
+			d.spec.Jobs = append(d.spec.Jobs, &godo.AppJobSpec{
+				Name: sanitizeComponentName(name + "-release"), GitHub: d.source.GitHub, GitLab: d.source.GitLab, Git: d.source.Git,
+				SourceDir: d.relPath(dir), EnvironmentSlug: environment, RunCommand: p[1], Kind: godo.AppJobSpecKind_PreDeploy,
+				InstanceCount: 1, InstanceSizeSlug: defaultInstanceSizeSlug,
+			})
+		default:
+			d.spec.Workers = append(d.spec.Workers, &godo.AppWorkerSpec{
+				Name: sanitizeComponentName(name + "-" + p[0]), GitHub: d.source.GitHub, GitLab: d.source.GitLab, Git: d.source.Git,
+				SourceDir: d.relPath(dir), EnvironmentSlug: environment, RunCommand: p[1],
+				InstanceCount: 1, InstanceSizeSlug: defaultInstanceSizeSlug,
+			})
+		}
+		d.detected = append(d.detected, fmt.Sprintf("%s: Procfile process %q", name, p[0]))
+	}
+}
+
+// detectComponents adds the components of the project in a directory and reports whether it found one.
+func (d *specDetector) detectComponents(dir, name string) (bool, error) {
+	var processes [][2]string
+	var webCommand string
+	if procfile := filepath.Join(dir, "Procfile"); fileExists(procfile) {
+		var err error
+		if processes, err = parseProcfile(procfile); err != nil {
+			return false, fmt.Errorf("failed to read %s: %w", procfile, err)
+		}
+		for _, p := range processes {
+			if p[0] == "web" {
+				webCommand = p[1]
+			}
+		}
+	}
+
+	if dockerfile := filepath.Join(dir, "Dockerfile"); fileExists(dockerfile) {
+		service := &godo.AppServiceSpec{Name: name, DockerfilePath: d.relPath(dockerfile), HTTPPort: dockerfilePort(dockerfile)}
+		d.detected = append(d.detected, fmt.Sprintf("%s: Dockerfile build", name))
+		if service.HTTPPort != 0 {
+			d.detected = append(d.detected, fmt.Sprintf("%s: port %d from EXPOSE", name, service.HTTPPort))
+		}
+		d.addService(dir, service)
+		return true, nil
+	}
+
+	if data, err := os.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		var pkg packageJSON
+		if err := json.Unmarshal(data, &pkg); err != nil {
+			return false, fmt.Errorf("failed to parse %s: %w", filepath.Join(dir, "package.json"), err)
+		}
+		_, hasBuild := pkg.Scripts["build"]
+		_, hasStart := pkg.Scripts["start"]
+		if hasBuild && webCommand == "" && (!hasStart || pkg.hasPackage("react-scripts")) {
+			for _, framework := range staticFrameworks {
+				if !pkg.hasPackage(framework.pkg) {
+					continue
+				}
+				d.spec.StaticSites = append(d.spec.StaticSites, &godo.AppStaticSiteSpec{
+					Name: name, GitHub: d.source.GitHub, GitLab: d.source.GitLab, Git: d.source.Git,
+					SourceDir: d.relPath(dir), EnvironmentSlug: "node-js", BuildCommand: "npm run build", OutputDir: framework.outputDir,
+					Envs: d.componentEnvs(dir, name),
+				})
+				d.detected = append(d.detected, fmt.Sprintf("%s: static site built with %s into %s", name, framework.pkg, framework.outputDir))
+				return true, nil
+			}
+		}
+		service := &godo.AppServiceSpec{Name: name, EnvironmentSlug: "node-js", RunCommand: webCommand}
+		if hasBuild {
+			service.BuildCommand = "npm run build"
+		}
+		if service.RunCommand == "" {
+			service.RunCommand = "npm start"
+			if !hasStart {
+				d.notes = append(d.notes, fmt.Sprintf("package.json of %s has no start script; set the run command of the service.", name))
+			}
+		}
+		d.detected = append(d.detected, fmt.Sprintf("%s: Node.js service from package.json", name))
+		d.addService(dir, service)
+		d.addProcesses(dir, name, "node-js", processes)
+		return true, nil
+	}
+
+	if fileExists(filepath.Join(dir, "go.mod")) {
+		d.detected = append(d.detected, fmt.Sprintf("%s: Go service from go.mod", name))
+		d.addService(dir, &godo.AppServiceSpec{Name: name, EnvironmentSlug: "go", RunCommand: webCommand})
+		d.addProcesses(dir, name, "go", processes)
+		return true, nil
+	}
+
+	for _, file := range []string{"requirements.txt", "Pipfile", "pyproject.toml"} {
+		path := filepath.Join(dir, file)
+		if !fileExists(path) {
+			continue
+		}
+		requirements, _ := os.ReadFile(path)
+		service := &godo.AppServiceSpec{Name: name, EnvironmentSlug: "python", RunCommand: webCommand}
+		if service.RunCommand == "" {
+			service.RunCommand = pythonRunCommand(dir, string(requirements))
+		}
+		if service.RunCommand == "" {
+			d.notes = append(d.notes, fmt.Sprintf("Could not tell how to start %s; add a Procfile or set the run command of the service.", name))
+		}
+		d.detected = append(d.detected, fmt.Sprintf("%s: Python service from %s", name, file))
+		d.addService(dir, service)
+		d.addProcesses(dir, name, "python", processes)
+		return true, nil
+	}
+
+	if fileExists(filepath.Join(dir, "index.html")) {
+		site := &godo.AppStaticSiteSpec{Name: name, GitHub: d.source.GitHub, GitLab: d.source.GitLab, Git: d.source.Git, SourceDir: d.relPath(dir)}
+		if fileExists(filepath.Join(dir, "404.html")) {
+			site.ErrorDocument = "404.html"
+		}
+		d.spec.StaticSites = append(d.spec.StaticSites, site)
+		d.detected = append(d.detected, fmt.Sprintf("%s: static site from index.html", name))
+		return true, nil
+	}
+	return false, nil
+}
+
+// detectSpec generates a starter app spec for the project in a local directory. When the directory itself is not a
+// recognized project, each of its subdirectories is tried as a component of a monorepo.
+func detectSpec(root, appName string) (*detectedSpec, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+	if appName == "" {
+		appName = filepath.Base(root)
+	}
+	appName = sanitizeComponentName(appName)
+
+	d := &specDetector{root: root, spec: &godo.AppSpec{Name: appName}}
+	if source, ok := detectGitSource(root); ok {
+		d.source = source
+		d.detected = append(d.detected, "source: git remote origin")
+	} else {
+		d.notes = append(d.notes, "No git remote was found; add a source (github, gitlab, git or image) to each component before creating the app.")
+	}
+
+	found, err := d.detectComponents(root, appName)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		entries, err := os.ReadDir(root)
+		if err != nil {
+			return nil, err
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+		for _, entry := range entries {
+			if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || skippedDirs[entry.Name()] {
+				continue
+			}
+			if _, err := d.detectComponents(filepath.Join(root, entry.Name()), sanitizeComponentName(entry.Name())); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(d.spec.Services)+len(d.spec.StaticSites)+len(d.spec.Workers)+len(d.spec.Jobs) == 0 {
+		return nil, fmt.Errorf("no Dockerfile, package.json, go.mod, Python project or index.html found in %s or its subdirectories", root)
+	}
+	if len(d.spec.Services) > 0 {
+		d.notes = append(d.notes, fmt.Sprintf("Services listen on $PORT, which App Platform sets to the http_port (%d unless detected otherwise).", defaultHTTPPort))
+	}
+	return &detectedSpec{Spec: d.spec, Detected: d.detected, Notes: d.notes}, nil
+}
+
+// detectAppSpec generates a starter app spec from a local directory.
+func (a *AppPlatformTool) detectAppSpec(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	path, ok := args["Path"].(string)
+	if !ok || path == "" {
+		return mcp.NewToolResultError("Path is required"), nil
+	}
+	name, _ := args["Name"].(string)
+
+	detected, err := detectSpec(path, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("spec detection failed", err), nil
+	}
+	detectedJSON, err := json.MarshalIndent(detected, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(detectedJSON)), nil
+}
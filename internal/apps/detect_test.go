@@ -0,0 +1,223 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeComponentName(t *testing.T) {
+	require.Equal(t, "my-app", sanitizeComponentName("My_App"))
+	require.Equal(t, "app-2048", sanitizeComponentName("2048"))
+	require.Equal(t, "a-very-long-repository-name-that", sanitizeComponentName("a-very-long-repository-name-that-goes-on"))
+}
+
+func TestDetectGitSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		remote   string
+		head     string
+		expected componentSource
+	}{
+		{
+			name:     "GitHub over SSH",
+			remote:   "git@github.com:acme/shop.git",
+			head:     "ref: refs/heads/main",
+			expected: componentSource{GitHub: &godo.GitHubSourceSpec{Repo: "acme/shop", Branch: "main", DeployOnPush: true}},
+		},
+		{
+			name:     "GitLab over HTTPS",
+			remote:   "https://gitlab.com/acme/shop",
+			head:     "ref: refs/heads/release/1.2",
+			expected: componentSource{GitLab: &godo.GitLabSourceSpec{Repo: "acme/shop", Branch: "release/1.2", DeployOnPush: true}},
+		},
+		{
+			name:     "Other host on a detached HEAD",
+			remote:   "https://git.example.com/shop.git",
+			head:     "3f2a8c1d9e4b5a6f7c8d9e0f1a2b3c4d5e6f7a8b",
+			expected: componentSource{Git: &godo.GitSourceSpec{RepoCloneURL: "https://git.example.com/shop.git"}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			root := t.TempDir()
+			require.NoError(t, os.Mkdir(filepath.Join(root, ".git"), 0o755))
+			config := "[core]\n\tbare = false\n[remote \"upstream\"]\n\turl = git@github.com:other/fork.git\n[remote \"origin\"]\n\turl = " + tc.remote + "\n"
+			require.NoError(t, os.WriteFile(filepath.Join(root, ".git", "config"), []byte(config), 0o644))
+			require.NoError(t, os.WriteFile(filepath.Join(root, ".git", "HEAD"), []byte(tc.head+"\n"), 0o644))
+
+			source, ok := detectGitSource(root)
+			require.True(t, ok)
+			require.Equal(t, tc.expected, source)
+		})
+	}
+
+	_, ok := detectGitSource(t.TempDir())
+	require.False(t, ok)
+}
+
+func TestDetectSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		dir      string
+		expected *godo.AppSpec
+	}{
+		{
+			name: "Node.js service with a Procfile and example env",
+			dir:  "node-service",
+			expected: &godo.AppSpec{
+				Name: "node-service",
+				Services: []*godo.AppServiceSpec{{
+					Name:             "node-service",
+					EnvironmentSlug:  "node-js",
+					BuildCommand:     "npm run build",
+					RunCommand:       "node dist/server.js",
+					HTTPPort:         8080,
+					InstanceCount:    1,
+					InstanceSizeSlug: defaultInstanceSizeSlug,
+					HealthCheck:      &godo.AppServiceSpecHealthCheck{HTTPPath: "/healthz"},
+					Envs: []*godo.AppVariableDefinition{
+						{Key: "LOG_LEVEL", Value: "info", Scope: godo.AppVariableScope_RunAndBuildTime},
+						{Key: "DATABASE_URL", Value: envPlaceholder, Scope: godo.AppVariableScope_RunAndBuildTime},
+						{Key: "STRIPE_API_KEY", Value: envPlaceholder, Type: godo.AppVariableType_Secret, Scope: godo.AppVariableScope_RunAndBuildTime},
+					},
+				}},
+				Workers: []*godo.AppWorkerSpec{{
+					Name:             "node-service-worker",
+					EnvironmentSlug:  "node-js",
+					RunCommand:       "node dist/worker.js",
+					InstanceCount:    1,
+					InstanceSizeSlug: defaultInstanceSizeSlug,
+				}},
+			},
+		},
+		{
+			name: "Vite static site",
+			dir:  "vite-site",
+			expected: &godo.AppSpec{
+				Name: "vite-site",
+				StaticSites: []*godo.AppStaticSiteSpec{{
+					Name:            "vite-site",
+					EnvironmentSlug: "node-js",
+					BuildCommand:    "npm run build",
+					OutputDir:       "dist",
+				}},
+			},
+		},
+		{
+			name: "Dockerfile takes precedence over go.mod",
+			dir:  "go-docker",
+			expected: &godo.AppSpec{
+				Name: "go-docker",
+				Services: []*godo.AppServiceSpec{{
+					Name:             "go-docker",
+					DockerfilePath:   "Dockerfile",
+					HTTPPort:         3000,
+					InstanceCount:    1,
+					InstanceSizeSlug: defaultInstanceSizeSlug,
+					HealthCheck:      &godo.AppServiceSpecHealthCheck{HTTPPath: "/health"},
+				}},
+			},
+		},
+		{
+			name: "Django with release and worker processes",
+			dir:  "python-procfile",
+			expected: &godo.AppSpec{
+				Name: "python-procfile",
+				Services: []*godo.AppServiceSpec{{
+					Name:             "python-procfile",
+					EnvironmentSlug:  "python",
+					RunCommand:       "gunicorn --worker-tmp-dir /dev/shm shop.wsgi",
+					HTTPPort:         8080,
+					InstanceCount:    1,
+					InstanceSizeSlug: defaultInstanceSizeSlug,
+				}},
+				Workers: []*godo.AppWorkerSpec{{
+					Name:             "python-procfile-worker",
+					EnvironmentSlug:  "python",
+					RunCommand:       "celery -A shop worker",
+					InstanceCount:    1,
+					InstanceSizeSlug: defaultInstanceSizeSlug,
+				}},
+				Jobs: []*godo.AppJobSpec{{
+					Name:             "python-procfile-release",
+					EnvironmentSlug:  "python",
+					RunCommand:       "python manage.py migrate",
+					Kind:             godo.AppJobSpecKind_PreDeploy,
+					InstanceCount:    1,
+					InstanceSizeSlug: defaultInstanceSizeSlug,
+				}},
+			},
+		},
+		{
+			name: "Plain static site",
+			dir:  "static-html",
+			expected: &godo.AppSpec{
+				Name:        "static-html",
+				StaticSites: []*godo.AppStaticSiteSpec{{Name: "static-html", ErrorDocument: "404.html"}},
+			},
+		},
+		{
+			name: "Monorepo",
+			dir:  "monorepo",
+			expected: &godo.AppSpec{
+				Name: "monorepo",
+				Services: []*godo.AppServiceSpec{{
+					Name:             "api",
+					SourceDir:        "api",
+					EnvironmentSlug:  "go",
+					HTTPPort:         8080,
+					InstanceCount:    1,
+					InstanceSizeSlug: defaultInstanceSizeSlug,
+				}},
+				StaticSites: []*godo.AppStaticSiteSpec{{Name: "web", SourceDir: "web"}},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			detected, err := detectSpec(filepath.Join("testdata", "detect", tc.dir), "")
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, detected.Spec)
+			require.NotEmpty(t, detected.Detected)
+			require.Contains(t, detected.Notes, "No git remote was found; add a source (github, gitlab, git or image) to each component before creating the app.")
+		})
+	}
+}
+
+func TestDetectAppSpec(t *testing.T) {
+	tool := &AppPlatformTool{}
+
+	resp, err := tool.detectAppSpec(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Path": filepath.Join("testdata", "detect", "vite-site"),
+		"Name": "Landing Page",
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var detected detectedSpec
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &detected))
+	require.Equal(t, "landing-page", detected.Spec.Name)
+	require.Equal(t, "landing-page", detected.Spec.StaticSites[0].Name)
+
+	// The generated spec validates against the schema used by apps-spec-import.
+	raw, err := os.ReadFile("spec/app-create-schema.json")
+	require.NoError(t, err)
+	schema, err := parseAppSpecSchema(raw)
+	require.NoError(t, err)
+	data, err := specToYAML(detected.Spec)
+	require.NoError(t, err)
+	_, err = specFromYAML(data, schema)
+	require.NoError(t, err)
+
+	for _, path := range []string{filepath.Join("testdata", "detect", "empty"), filepath.Join("testdata", "detect", "missing")} {
+		resp, err = tool.detectAppSpec(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Path": path}}})
+		require.NoError(t, err)
+		require.True(t, resp.IsError)
+	}
+}
@@ -0,0 +1,354 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// domainsPageSize is the page size used to list the domains and records of the account.
+const domainsPageSize = 200
+
+// dnsRecord is a DNS record an app domain needs.
+type dnsRecord struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Data string `json:"data"`
+}
+
+// domainDNSStatus is the result of cross-checking an app domain against the DNS zones of the account.
+type domainDNSStatus struct {
+	Zone                 string      `json:"zone,omitempty"`
+	ZoneInAccount        bool        `json:"zone_in_account"`
+	ManagedByAppPlatform bool        `json:"managed_by_app_platform"`
+	Expected             []dnsRecord `json:"expected,omitempty"`
+	Missing              []dnsRecord `json:"missing,omitempty"`
+	Note                 string      `json:"note,omitempty"`
+}
+
+// appDomainStatus is an app domain with its certificate and DNS status.
+type appDomainStatus struct {
+	Domain               string           `json:"domain"`
+	Type                 string           `json:"type"`
+	Wildcard             bool             `json:"wildcard,omitempty"`
+	Phase                string           `json:"phase,omitempty"`
+	CertificateExpiresAt *time.Time       `json:"certificate_expires_at,omitempty"`
+	FailedSteps          []string         `json:"failed_steps,omitempty"`
+	DNS                  *domainDNSStatus `json:"dns"`
+}
+
+// listAccountZones lists the names of all DNS zones of the account.
+func (a *AppPlatformTool) listAccountZones(ctx context.Context) ([]string, error) {
+	var zones []string
+	opt := &godo.ListOptions{Page: 1, PerPage: domainsPageSize}
+	for {
+		domains, resp, err := a.client.Domains.List(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range domains {
+			zones = append(zones, d.Name)
+		}
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			return zones, nil
+		}
+		opt.Page++
+	}
+}
+
+// listZoneRecords lists all records of a DNS zone.
+func (a *AppPlatformTool) listZoneRecords(ctx context.Context, zone string) ([]godo.DomainRecord, error) {
+	var records []godo.DomainRecord
+	opt := &godo.ListOptions{Page: 1, PerPage: domainsPageSize}
+	for {
+		page, resp, err := a.client.Domains.Records(ctx, zone, opt)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, page...)
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			return records, nil
+		}
+		opt.Page++
+	}
+}
+
+// zoneForDomain returns the most specific zone a domain belongs to.
+func zoneForDomain(domain string, zones []string) (string, bool) {
+	best := ""
+	for _, zone := range zones {
+		zone = strings.ToLower(zone)
+		if (domain == zone || strings.HasSuffix(domain, "."+zone)) && len(zone) > len(best) {
+			best = zone
+		}
+	}
+	return best, best != ""
+}
+
+// recordName returns the name of a record for a host relative to its zone, as used by the DNS API.
+func recordName(host, zone string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == zone {
+		return "@"
+	}
+	return strings.TrimSuffix(host, "."+zone)
+}
+
+// ingressHost returns the hostname of the default ingress of an app, which custom domains point to.
+func ingressHost(defaultIngress string) string {
+	if u, err := url.Parse(defaultIngress); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return strings.TrimSuffix(defaultIngress, "/")
+}
+
+// hasRecord reports whether a zone has a record, comparing names and data case-insensitively and ignoring
+// trailing dots.
+func hasRecord(records []godo.DomainRecord, want dnsRecord) bool {
+	normalize := func(s string) string { return strings.TrimSuffix(strings.ToLower(s), ".") }
+	for _, r := range records {
+		if strings.EqualFold(r.Type, want.Type) && normalize(r.Name) == normalize(want.Name) && normalize(r.Data) == normalize(want.Data) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDomainDNS cross-checks the records an app domain needs against its zone in the account.
+func (a *AppPlatformTool) checkDomainDNS(ctx context.Context, spec *godo.AppDomainSpec, domain *godo.AppDomain, defaultIngress string, zones []string) *domainDNSStatus {
+	name := strings.ToLower(spec.Domain)
+	status := &domainDNSStatus{ManagedByAppPlatform: spec.Zone != ""}
+	zone, ok := zoneForDomain(name, zones)
+	if !ok {
+		status.Note = "the domain is not in a DNS zone of this account; create the expected records with your DNS provider"
+		status.Zone = spec.Zone
+	} else {
+		status.Zone = zone
+		status.ZoneInAccount = true
+	}
+
+	if zone != "" && name == zone {
+		if !status.ManagedByAppPlatform {
+			status.Note = "apex domains cannot use a CNAME record; set the zone on the app domain so App Platform manages its records"
+		}
+	} else if host := ingressHost(defaultIngress); host != "" {
+		cname := dnsRecord{Type: "CNAME", Name: name, Data: host + "."}
+		if spec.Wildcard {
+			cname.Name = "*." + name
+		}
+		status.Expected = append(status.Expected, cname)
+	}
+	if domain != nil {
+		validations := domain.Validations
+		if len(validations) == 0 && domain.Validation != nil {
+			validations = []*godo.AppDomainValidation{domain.Validation}
+		}
+		for _, v := range validations {
+			if v != nil && v.TXTName != "" {
+				status.Expected = append(status.Expected, dnsRecord{Type: "TXT", Name: v.TXTName, Data: v.TXTValue})
+			}
+		}
+	}
+	if !status.ZoneInAccount || len(status.Expected) == 0 {
+		return status
+	}
+
+	records, err := a.listZoneRecords(ctx, zone)
+	if err != nil {
+		status.Note = fmt.Sprintf("failed to list the records of zone %s: %v", zone, err)
+		return status
+	}
+	// Expected records use fully qualified names; the zone records and the records to create are relative to the zone.
+	for i, want := range status.Expected {
+		want.Name = recordName(want.Name, zone)
+		status.Expected[i] = want
+		if !hasRecord(records, want) {
+			status.Missing = append(status.Missing, want)
+		}
+	}
+	if len(status.Missing) > 0 && status.Note == "" {
+		status.Note = fmt.Sprintf("create the missing records in zone %s with domain-record-create", zone)
+	}
+	return status
+}
+
+// listDomains lists the custom domains of an app with their certificate status and a cross-check of their DNS
+// records against the zones of the account.
+func (a *AppPlatformTool) listDomains(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, ok := req.GetArguments()["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	zones, err := a.listAccountZones(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	domainsByName := map[string]*godo.AppDomain{}
+	for _, d := range app.Domains {
+		if d.Spec != nil {
+			domainsByName[strings.ToLower(d.Spec.Domain)] = d
+		}
+	}
+
+	statuses := []appDomainStatus{}
+	for _, spec := range app.Spec.Domains {
+		domain := domainsByName[strings.ToLower(spec.Domain)]
+		status := appDomainStatus{Domain: spec.Domain, Type: string(spec.Type), Wildcard: spec.Wildcard}
+		if status.Type == "" {
+			status.Type = string(godo.AppDomainSpecType_Alias)
+		}
+		if domain != nil {
+			status.Phase = string(domain.Phase)
+			if !domain.CertificateExpiresAt.IsZero() {
+				expiresAt := domain.CertificateExpiresAt
+				status.CertificateExpiresAt = &expiresAt
+			}
+			if domain.Progress != nil {
+				status.FailedSteps = failedDomainSteps("", domain.Progress.Steps)
+			}
+		}
+		status.DNS = a.checkDomainDNS(ctx, spec, domain, app.DefaultIngress, zones)
+		statuses = append(statuses, status)
+	}
+
+	statusesJSON, err := json.MarshalIndent(statuses, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(statusesJSON)), nil
+}
+
+// failedDomainSteps lists the failed domain progress steps with their reason.
+func failedDomainSteps(prefix string, steps []*godo.AppDomainProgressStep) []string {
+	var failed []string
+	for _, s := range steps {
+		if s == nil {
+			continue
+		}
+		name := s.Name
+		if prefix != "" {
+			name = prefix + "/" + name
+		}
+		if s.Status == godo.AppJobSpecKindProgressStepStatus_Error {
+			step := name
+			if s.Reason != nil {
+				step = fmt.Sprintf("%s: %s %s", name, s.Reason.Code, s.Reason.Message)
+			}
+			failed = append(failed, strings.TrimSpace(step))
+		}
+		failed = append(failed, failedDomainSteps(name, s.Steps)...)
+	}
+	return failed
+}
+
+// addDomain adds a custom domain to an app, defaulting its zone to the matching DNS zone of the account.
+func (a *AppPlatformTool) addDomain(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	name, ok := args["Domain"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Domain is required"), nil
+	}
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	domainType := godo.AppDomainSpecType_Alias
+	if v, ok := args["Type"].(string); ok && v != "" {
+		domainType = godo.AppDomainSpecType(strings.ToUpper(v))
+	}
+	if domainType != godo.AppDomainSpecType_Primary && domainType != godo.AppDomainSpecType_Alias {
+		return mcp.NewToolResultError("Type must be PRIMARY or ALIAS"), nil
+	}
+	wildcard, _ := args["Wildcard"].(bool)
+	minimumTLSVersion, _ := args["MinimumTLSVersion"].(string)
+	zone, hasZone := args["Zone"].(string)
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	for _, d := range app.Spec.Domains {
+		if strings.EqualFold(d.Domain, name) {
+			return mcp.NewToolResultError(fmt.Sprintf("domain %s is already configured on app %s", name, appID)), nil
+		}
+	}
+	// Without an explicit zone, let App Platform manage the records when the domain is in a zone of this account.
+	if !hasZone {
+		zones, err := a.listAccountZones(ctx)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		zone, _ = zoneForDomain(name, zones)
+	}
+
+	domain := &godo.AppDomainSpec{Domain: name, Type: domainType, Wildcard: wildcard, Zone: zone, MinimumTLSVersion: minimumTLSVersion}
+	if domainType == godo.AppDomainSpecType_Primary {
+		// An app has a single primary domain; the previous one becomes an alias.
+		for _, d := range app.Spec.Domains {
+			if d.Type == godo.AppDomainSpecType_Primary {
+				d.Type = godo.AppDomainSpecType_Alias
+			}
+		}
+	}
+	app.Spec.Domains = append(app.Spec.Domains, domain)
+
+	updated, _, err := a.client.Apps.Update(ctx, appID, &godo.AppUpdateRequest{Spec: app.Spec})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	result := map[string]any{"app_id": appID, "domain": domain}
+	if updated.PendingDeployment != nil {
+		result["deployment_id"] = updated.PendingDeployment.ID
+	}
+	resultJSON, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(resultJSON)), nil
+}
+
+// removeDomain removes a custom domain from an app.
+func (a *AppPlatformTool) removeDomain(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	name, ok := args["Domain"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Domain is required"), nil
+	}
+	name = strings.TrimSuffix(name, ".")
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	kept := make([]*godo.AppDomainSpec, 0, len(app.Spec.Domains))
+	for _, d := range app.Spec.Domains {
+		if !strings.EqualFold(d.Domain, name) {
+			kept = append(kept, d)
+		}
+	}
+	if len(kept) == len(app.Spec.Domains) {
+		return mcp.NewToolResultError(fmt.Sprintf("domain %s is not configured on app %s", name, appID)), nil
+	}
+	app.Spec.Domains = kept
+
+	if _, _, err := a.client.Apps.Update(ctx, appID, &godo.AppUpdateRequest{Spec: app.Spec}); err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Domain %s removed from app %s. DNS records pointing to the app are left in place.", name, appID)), nil
+}
@@ -0,0 +1,184 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupDomainsMock(t *testing.T) (*AppPlatformTool, *MockAppsService, *MockDomainsService) {
+	ctrl := gomock.NewController(t)
+	appService := NewMockAppsService(ctrl)
+	domainService := NewMockDomainsService(ctrl)
+	return &AppPlatformTool{client: &godo.Client{Apps: appService, Domains: domainService}}, appService, domainService
+}
+
+func TestZoneForDomain(t *testing.T) {
+	zones := []string{"example.com", "shop.example.com", "example.org"}
+
+	zone, ok := zoneForDomain("api.shop.example.com", zones)
+	require.True(t, ok)
+	require.Equal(t, "shop.example.com", zone)
+	zone, ok = zoneForDomain("example.com", zones)
+	require.True(t, ok)
+	require.Equal(t, "example.com", zone)
+	_, ok = zoneForDomain("notexample.com", zones)
+	require.False(t, ok)
+
+	require.Equal(t, "@", recordName("example.com.", "example.com"))
+	require.Equal(t, "*.app", recordName("*.app.example.com", "example.com"))
+}
+
+func TestListDomains(t *testing.T) {
+	tool, appService, domainService := setupDomainsMock(t)
+	app := &godo.App{
+		ID:             "app-123",
+		DefaultIngress: "https://shop-abc12.ondigitalocean.app",
+		Spec: &godo.AppSpec{
+			Name: "shop",
+			Domains: []*godo.AppDomainSpec{
+				{Domain: "www.example.com", Type: godo.AppDomainSpecType_Primary},
+				{Domain: "api.example.com", Zone: "example.com"},
+				{Domain: "shop.example.net"},
+			},
+		},
+		Domains: []*godo.AppDomain{
+			{
+				Spec:        &godo.AppDomainSpec{Domain: "www.example.com"},
+				Phase:       godo.AppJobSpecKindPHASE_Configuring,
+				Validations: []*godo.AppDomainValidation{{TXTName: "_acme-challenge.www.example.com", TXTValue: "token"}},
+				Progress: &godo.AppDomainProgress{Steps: []*godo.AppDomainProgressStep{{
+					Name:   "dns",
+					Status: godo.AppJobSpecKindProgressStepStatus_Error,
+					Reason: &godo.AppDomainProgressStepReason{Code: "CNAMEMissing", Message: "no CNAME record found"},
+				}}},
+			},
+			{Spec: &godo.AppDomainSpec{Domain: "api.example.com"}, Phase: godo.AppJobSpecKindPHASE_Active},
+		},
+	}
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(app, nil, nil).Times(1)
+	domainService.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Domain{{Name: "example.com"}}, nil, nil).Times(1)
+	domainService.EXPECT().Records(gomock.Any(), "example.com", gomock.Any()).Return([]godo.DomainRecord{
+		{Type: "TXT", Name: "_acme-challenge.www", Data: "token"},
+		{Type: "CNAME", Name: "api", Data: "shop-abc12.ondigitalocean.app."},
+	}, nil, nil).Times(2)
+
+	resp, err := tool.listDomains(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var statuses []appDomainStatus
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &statuses))
+	require.Len(t, statuses, 3)
+
+	www := statuses[0]
+	require.Equal(t, "CONFIGURING", www.Phase)
+	require.Equal(t, []string{"dns: CNAMEMissing no CNAME record found"}, www.FailedSteps)
+	require.Equal(t, "example.com", www.DNS.Zone)
+	require.False(t, www.DNS.ManagedByAppPlatform)
+	require.Equal(t, []dnsRecord{{Type: "CNAME", Name: "www", Data: "shop-abc12.ondigitalocean.app."}}, www.DNS.Missing)
+
+	api := statuses[1]
+	require.Equal(t, "ALIAS", api.Type)
+	require.True(t, api.DNS.ManagedByAppPlatform)
+	require.Empty(t, api.DNS.Missing)
+
+	external := statuses[2]
+	require.False(t, external.DNS.ZoneInAccount)
+	require.Equal(t, []dnsRecord{{Type: "CNAME", Name: "shop.example.net", Data: "shop-abc12.ondigitalocean.app."}}, external.DNS.Expected)
+}
+
+func TestAddDomain(t *testing.T) {
+	spec := func(domains ...*godo.AppDomainSpec) *godo.AppSpec {
+		return &godo.AppSpec{Name: "shop", Domains: domains}
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mock        func(app *MockAppsService, domains *MockDomainsService)
+		expectError bool
+	}{
+		{
+			name: "Primary domain in an account zone",
+			args: map[string]any{"AppID": "app-123", "Domain": "Shop.Example.com", "Type": "PRIMARY"},
+			mock: func(app *MockAppsService, domains *MockDomainsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").
+					Return(&godo.App{Spec: spec(&godo.AppDomainSpec{Domain: "old.example.com", Type: godo.AppDomainSpecType_Primary})}, nil, nil).Times(1)
+				domains.EXPECT().List(gomock.Any(), gomock.Any()).Return([]godo.Domain{{Name: "example.com"}}, nil, nil).Times(1)
+				app.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: spec(
+					&godo.AppDomainSpec{Domain: "old.example.com", Type: godo.AppDomainSpecType_Alias},
+					&godo.AppDomainSpec{Domain: "shop.example.com", Type: godo.AppDomainSpecType_Primary, Zone: "example.com"},
+				)}).Return(&godo.App{PendingDeployment: &godo.Deployment{ID: "deploy-1"}}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Explicitly unmanaged zone",
+			args: map[string]any{"AppID": "app-123", "Domain": "shop.example.com", "Zone": ""},
+			mock: func(app *MockAppsService, _ *MockDomainsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{Spec: spec()}, nil, nil).Times(1)
+				app.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: spec(
+					&godo.AppDomainSpec{Domain: "shop.example.com", Type: godo.AppDomainSpecType_Alias},
+				)}).Return(&godo.App{}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Existing domain",
+			args: map[string]any{"AppID": "app-123", "Domain": "shop.example.com"},
+			mock: func(app *MockAppsService, _ *MockDomainsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{Spec: spec(&godo.AppDomainSpec{Domain: "shop.example.com"})}, nil, nil).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"AppID": "app-123", "Domain": "shop.example.com", "Zone": "example.com"},
+			mock: func(app *MockAppsService, _ *MockDomainsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{Spec: spec()}, nil, nil).Times(1)
+				app.EXPECT().Update(gomock.Any(), "app-123", gomock.Any()).Return(nil, nil, fmt.Errorf("api error")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Invalid type",
+			args:        map[string]any{"AppID": "app-123", "Domain": "shop.example.com", "Type": "DEFAULT"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tool, appService, domainService := setupDomainsMock(t)
+			if tc.mock != nil {
+				tc.mock(appService, domainService)
+			}
+			resp, err := tool.addDomain(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
+
+func TestRemoveDomain(t *testing.T) {
+	tool, appService, _ := setupDomainsMock(t)
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{Spec: &godo.AppSpec{Domains: []*godo.AppDomainSpec{
+		{Domain: "www.example.com"},
+		{Domain: "api.example.com"},
+	}}}, nil, nil).Times(2)
+	appService.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: &godo.AppSpec{Domains: []*godo.AppDomainSpec{
+		{Domain: "api.example.com"},
+	}}}).Return(&godo.App{}, nil, nil).Times(1)
+
+	resp, err := tool.removeDomain(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Domain": "WWW.example.com."}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.removeDomain(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Domain": "missing.example.com"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -0,0 +1,292 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// redactedValue replaces the value of secret environment variables in tool output.
+const redactedValue = "[REDACTED]"
+
+// envKeyPattern matches valid environment variable names.
+var envKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// envVar is an environment variable as shown by the env tools, with secret values redacted.
+type envVar struct {
+	Component string `json:"component"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+	Type      string `json:"type"`
+	Scope     string `json:"scope"`
+}
+
+// envChangeResult is the output of the set and unset tools.
+type envChangeResult struct {
+	AppID        string   `json:"app_id"`
+	Component    string   `json:"component"`
+	Added        []envVar `json:"added,omitempty"`
+	Updated      []envVar `json:"updated,omitempty"`
+	Removed      []string `json:"removed,omitempty"`
+	DeploymentID string   `json:"deployment_id,omitempty"`
+}
+
+// redactEnvVar converts a variable definition for output, redacting secret values.
+func redactEnvVar(component string, v *godo.AppVariableDefinition) envVar {
+	out := envVar{Component: component, Key: v.Key, Value: v.Value, Type: string(v.Type), Scope: string(v.Scope)}
+	if out.Type == "" {
+		out.Type = string(godo.AppVariableType_General)
+	}
+	if out.Scope == "" || out.Scope == string(godo.AppVariableScope_Unset) {
+		out.Scope = string(godo.AppVariableScope_RunAndBuildTime)
+	}
+	if v.Type == godo.AppVariableType_Secret {
+		out.Value = redactedValue
+	}
+	return out
+}
+
+// componentLabel names the app-level scope in tool output.
+func componentLabel(component string) string {
+	if component == "" {
+		return "app"
+	}
+	return component
+}
+
+// specEnvs returns the environment variables of the app, when component is empty, or of a component of the spec.
+func specEnvs(spec *godo.AppSpec, component string) (*[]*godo.AppVariableDefinition, error) {
+	if component == "" {
+		return &spec.Envs, nil
+	}
+	for _, s := range spec.Services {
+		if s.Name == component {
+			return &s.Envs, nil
+		}
+	}
+	for _, w := range spec.Workers {
+		if w.Name == component {
+			return &w.Envs, nil
+		}
+	}
+	for _, j := range spec.Jobs {
+		if j.Name == component {
+			return &j.Envs, nil
+		}
+	}
+	for _, s := range spec.StaticSites {
+		if s.Name == component {
+			return &s.Envs, nil
+		}
+	}
+	for _, f := range spec.Functions {
+		if f.Name == component {
+			return &f.Envs, nil
+		}
+	}
+	return nil, fmt.Errorf("component %s not found or does not support environment variables", component)
+}
+
+// envVarsFromArgs parses the Vars argument of the set tool.
+func envVarsFromArgs(args map[string]any) ([]*godo.AppVariableDefinition, error) {
+	raw, ok := args["Vars"].([]any)
+	if !ok || len(raw) == 0 {
+		return nil, fmt.Errorf("at least one variable is required in Vars")
+	}
+	vars := make([]*godo.AppVariableDefinition, 0, len(raw))
+	for i, item := range raw {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("entry %d of Vars must be an object", i)
+		}
+		key, _ := m["Key"].(string)
+		if !envKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid key %q in entry %d of Vars", key, i)
+		}
+		value, ok := m["Value"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing Value for %s", key)
+		}
+		v := &godo.AppVariableDefinition{Key: key, Value: value}
+		if t, ok := m["Type"].(string); ok && t != "" {
+			v.Type = godo.AppVariableType(strings.ToUpper(t))
+			if v.Type != godo.AppVariableType_General && v.Type != godo.AppVariableType_Secret {
+				return nil, fmt.Errorf("invalid Type for %s: must be GENERAL or SECRET", key)
+			}
+		}
+		if s, ok := m["Scope"].(string); ok && s != "" {
+			v.Scope = godo.AppVariableScope(strings.ToUpper(s))
+			switch v.Scope {
+			case godo.AppVariableScope_RunTime, godo.AppVariableScope_BuildTime, godo.AppVariableScope_RunAndBuildTime:
+			default:
+				return nil, fmt.Errorf("invalid Scope for %s: must be RUN_TIME, BUILD_TIME or RUN_AND_BUILD_TIME", key)
+			}
+		}
+		vars = append(vars, v)
+	}
+	return vars, nil
+}
+
+// listEnvVars lists the environment variables of an app and its components, with secret values redacted.
+func (a *AppPlatformTool) listEnvVars(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	component, _ := args["Component"].(string)
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	vars := []envVar{}
+	if component != "" {
+		envs, err := specEnvs(app.Spec, component)
+		if err != nil {
+			return mcp.NewToolResultError(err.Error()), nil
+		}
+		for _, v := range *envs {
+			vars = append(vars, redactEnvVar(component, v))
+		}
+	} else {
+		for _, v := range app.Spec.Envs {
+			vars = append(vars, redactEnvVar(componentLabel(""), v))
+		}
+		_ = godo.ForEachAppSpecComponent(app.Spec, func(c godo.AppBuildableComponentSpec) error {
+			for _, v := range c.GetEnvs() {
+				vars = append(vars, redactEnvVar(c.GetName(), v))
+			}
+			return nil
+		})
+	}
+
+	varsJSON, err := json.MarshalIndent(vars, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(varsJSON)), nil
+}
+
+// updateEnvVars applies a change to the environment variables of an app or component and deploys the app.
+func (a *AppPlatformTool) updateEnvVars(ctx context.Context, appID, component string, change func(envs *[]*godo.AppVariableDefinition, result *envChangeResult)) (*mcp.CallToolResult, error) {
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	envs, err := specEnvs(app.Spec, component)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	result := &envChangeResult{AppID: appID, Component: componentLabel(component)}
+	change(envs, result)
+	if len(result.Added) == 0 && len(result.Updated) == 0 && len(result.Removed) == 0 {
+		return mcp.NewToolResultText("The environment variables are unchanged; nothing to deploy"), nil
+	}
+
+	// Only the environment variables of the fetched spec were modified, so the rest of the spec is sent back as is.
+	updated, _, err := a.client.Apps.Update(ctx, appID, &godo.AppUpdateRequest{Spec: app.Spec})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if updated.PendingDeployment != nil {
+		result.DeploymentID = updated.PendingDeployment.ID
+	} else {
+		deployment, _, err := a.client.Apps.CreateDeployment(ctx, appID)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		result.DeploymentID = deployment.ID
+	}
+
+	resultJSON, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(resultJSON)), nil
+}
+
+// setEnvVars adds or updates environment variables of an app or component and deploys the app.
+func (a *AppPlatformTool) setEnvVars(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	component, _ := args["Component"].(string)
+	vars, err := envVarsFromArgs(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	label := componentLabel(component)
+	return a.updateEnvVars(ctx, appID, component, func(envs *[]*godo.AppVariableDefinition, result *envChangeResult) {
+		for _, v := range vars {
+			var existing *godo.AppVariableDefinition
+			for _, e := range *envs {
+				if e.Key == v.Key {
+					existing = e
+					break
+				}
+			}
+			if existing == nil {
+				*envs = append(*envs, v)
+				result.Added = append(result.Added, redactEnvVar(label, v))
+				continue
+			}
+			// Unspecified attributes keep their current setting.
+			if v.Type == "" {
+				v.Type = existing.Type
+			}
+			if v.Scope == "" {
+				v.Scope = existing.Scope
+			}
+			// Secret values are stored encrypted, so a secret cannot be compared and is always updated.
+			if v.Type != godo.AppVariableType_Secret && *existing == *v {
+				continue
+			}
+			*existing = *v
+			result.Updated = append(result.Updated, redactEnvVar(label, v))
+		}
+	})
+}
+
+// unsetEnvVars removes environment variables from an app or component and deploys the app.
+func (a *AppPlatformTool) unsetEnvVars(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	component, _ := args["Component"].(string)
+	keys := map[string]bool{}
+	if raw, ok := args["Keys"].([]any); ok {
+		for _, k := range raw {
+			if key, ok := k.(string); ok && key != "" {
+				keys[key] = true
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return mcp.NewToolResultError("Keys is required"), nil
+	}
+
+	return a.updateEnvVars(ctx, appID, component, func(envs *[]*godo.AppVariableDefinition, result *envChangeResult) {
+		kept := make([]*godo.AppVariableDefinition, 0, len(*envs))
+		for _, e := range *envs {
+			if keys[e.Key] {
+				result.Removed = append(result.Removed, e.Key)
+				continue
+			}
+			kept = append(kept, e)
+		}
+		*envs = kept
+	})
+}
@@ -0,0 +1,170 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListEnvVars(t *testing.T) {
+	spec := testAppSpec()
+	spec.Envs = []*godo.AppVariableDefinition{{Key: "REGION", Value: "nyc", Scope: godo.AppVariableScope_RunTime}}
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: spec}, nil, nil).Times(3)
+
+	resp, err := tool.listEnvVars(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var vars []envVar
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &vars))
+	require.Equal(t, []envVar{
+		{Component: "app", Key: "REGION", Value: "nyc", Type: "GENERAL", Scope: "RUN_TIME"},
+		{Component: "web", Key: "LOG_LEVEL", Value: "info", Type: "GENERAL", Scope: "RUN_AND_BUILD_TIME"},
+		{Component: "web", Key: "API_KEY", Value: redactedValue, Type: "SECRET", Scope: "RUN_AND_BUILD_TIME"},
+	}, vars)
+	require.NotContains(t, resp.Content[0].(mcp.TextContent).Text, "EV[1:abc]")
+
+	resp, err = tool.listEnvVars(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Component": "api"}}})
+	require.NoError(t, err)
+	require.Equal(t, "[]", resp.Content[0].(mcp.TextContent).Text)
+
+	resp, err = tool.listEnvVars(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Component": "db"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestSetEnvVars(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         map[string]any
+		mock         func(app *MockAppsService)
+		expectError  bool
+		expectedText []string
+	}{
+		{
+			name: "Add a secret and update a variable of a component",
+			args: map[string]any{
+				"AppID":     "app-123",
+				"Component": "web",
+				"Vars": []any{
+					map[string]any{"Key": "DB_PASSWORD", "Value": "hunter2", "Type": "secret", "Scope": "RUN_TIME"},
+					map[string]any{"Key": "LOG_LEVEL", "Value": "debug"},
+				},
+			},
+			mock: func(app *MockAppsService) {
+				expected := testAppSpec()
+				expected.Services[0].Envs[0].Value = "debug"
+				expected.Services[0].Envs = append(expected.Services[0].Envs, &godo.AppVariableDefinition{
+					Key: "DB_PASSWORD", Value: "hunter2", Type: godo.AppVariableType_Secret, Scope: godo.AppVariableScope_RunTime,
+				})
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+				app.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: expected}).
+					Return(&godo.App{ID: "app-123", PendingDeployment: &godo.Deployment{ID: "deploy-9"}}, nil, nil).Times(1)
+			},
+			expectedText: []string{`"DB_PASSWORD"`, redactedValue, `"debug"`, "deploy-9"},
+		},
+		{
+			name: "Set an app-level variable and deploy explicitly",
+			args: map[string]any{"AppID": "app-123", "Vars": []any{map[string]any{"Key": "REGION", "Value": "nyc"}}},
+			mock: func(app *MockAppsService) {
+				expected := testAppSpec()
+				expected.Envs = []*godo.AppVariableDefinition{{Key: "REGION", Value: "nyc"}}
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+				app.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: expected}).Return(&godo.App{ID: "app-123"}, nil, nil).Times(1)
+				app.EXPECT().CreateDeployment(gomock.Any(), "app-123").Return(&godo.Deployment{ID: "deploy-10"}, nil, nil).Times(1)
+			},
+			expectedText: []string{`"component": "app"`, "deploy-10"},
+		},
+		{
+			name: "Unchanged variable",
+			args: map[string]any{"AppID": "app-123", "Component": "web", "Vars": []any{map[string]any{"Key": "LOG_LEVEL", "Value": "info"}}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+			},
+			expectedText: []string{"unchanged"},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"AppID": "app-123", "Vars": []any{map[string]any{"Key": "REGION", "Value": "nyc"}}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+				app.EXPECT().Update(gomock.Any(), "app-123", gomock.Any()).Return(nil, nil, fmt.Errorf("api error")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Invalid key",
+			args:        map[string]any{"AppID": "app-123", "Vars": []any{map[string]any{"Key": "1-BAD", "Value": "x"}}},
+			expectError: true,
+		},
+		{
+			name:        "Invalid scope",
+			args:        map[string]any{"AppID": "app-123", "Vars": []any{map[string]any{"Key": "GOOD", "Value": "x", "Scope": "ALWAYS"}}},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			resp, err := tool.setEnvVars(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			text := resp.Content[0].(mcp.TextContent).Text
+			for _, s := range tc.expectedText {
+				require.True(t, strings.Contains(text, s), "expected %q in %q", s, text)
+			}
+			require.NotContains(t, text, "hunter2")
+		})
+	}
+}
+
+func TestUnsetEnvVars(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	expected := testAppSpec()
+	expected.Services[0].Envs = expected.Services[0].Envs[:1]
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(2)
+	appService.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: expected}).
+		Return(&godo.App{ID: "app-123", PendingDeployment: &godo.Deployment{ID: "deploy-9"}}, nil, nil).Times(1)
+
+	resp, err := tool.unsetEnvVars(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"AppID":     "app-123",
+		"Component": "web",
+		"Keys":      []any{"API_KEY", "MISSING"},
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var result envChangeResult
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+	require.Equal(t, envChangeResult{AppID: "app-123", Component: "web", Removed: []string{"API_KEY"}, DeploymentID: "deploy-9"}, result)
+
+	// Removing only variables that do not exist does not deploy.
+	resp, err = tool.unsetEnvVars(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"AppID": "app-123",
+		"Keys":  []any{"MISSING"},
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "unchanged")
+
+	resp, err = tool.unsetEnvVars(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -1,3 +1,3 @@
 package apps
 
-//go:generate mockgen -destination=./mocks.go -package apps github.com/digitalocean/godo  AppsService
+//go:generate mockgen -destination=./mocks.go -package apps github.com/digitalocean/godo  AppsService,DomainsService
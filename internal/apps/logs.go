@@ -0,0 +1,187 @@
+package apps
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	defaultLogTailLines = 200
+	maxLogTailLines     = 5000
+	// maxLogBytes bounds the log content downloaded for a single request.
+	maxLogBytes = 16 << 20
+)
+
+// logsHTTPClient downloads the log content from the URLs returned by the API.
+var logsHTTPClient = &http.Client{Timeout: 60 * time.Second}
+
+// logTypes maps the log type argument to the API log type.
+var logTypes = map[string]godo.AppLogType{
+	"build":         godo.AppLogTypeBuild,
+	"deploy":        godo.AppLogTypeDeploy,
+	"run":           godo.AppLogTypeRun,
+	"run_restarted": godo.AppLogTypeRunRestarted,
+}
+
+// fetchLogLines downloads the log content of a URL line by line.
+func fetchLogLines(ctx context.Context, url string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := logsHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download logs: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download logs: unexpected status %s", resp.Status)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(io.LimitReader(resp.Body, maxLogBytes))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read logs: %w", err)
+	}
+	return lines, nil
+}
+
+// filterLogLines keeps the lines matching filter, if any, and then the last tail lines.
+func filterLogLines(lines []string, filter *regexp.Regexp, tail int) []string {
+	if filter != nil {
+		matching := make([]string, 0, len(lines))
+		for _, line := range lines {
+			if filter.MatchString(line) {
+				matching = append(matching, line)
+			}
+		}
+		lines = matching
+	}
+	if len(lines) > tail {
+		lines = lines[len(lines)-tail:]
+	}
+	return lines
+}
+
+// getLogs retrieves the build, deploy, run or crashed-run logs of an app, tailed and optionally filtered.
+func (a *AppPlatformTool) getLogs(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	typeName := "run"
+	if v, ok := args["Type"].(string); ok && v != "" {
+		typeName = strings.ToLower(v)
+	}
+	logType, ok := logTypes[typeName]
+	if !ok {
+		return mcp.NewToolResultError("Type must be one of build, deploy, run or run_restarted"), nil
+	}
+	tail := defaultLogTailLines
+	if v, ok := args["TailLines"].(float64); ok && v > 0 {
+		tail = min(int(v), maxLogTailLines)
+	}
+	var filter *regexp.Regexp
+	if v, ok := args["Filter"].(string); ok && v != "" {
+		pattern := v
+		if ignoreCase, _ := args["IgnoreCase"].(bool); ignoreCase {
+			pattern = "(?i)" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return mcp.NewToolResultError(fmt.Sprintf("invalid Filter: %v", err)), nil
+		}
+		filter = re
+	}
+	component, _ := args["Component"].(string)
+	deploymentID, _ := args["DeploymentID"].(string)
+
+	// Build and deploy logs belong to a deployment. Without one the API returns the logs of the active deployment,
+	// which is not the one that failed, so default to the most recent deployment.
+	if deploymentID == "" && (logType == godo.AppLogTypeBuild || logType == godo.AppLogTypeDeploy) {
+		deployments, _, err := a.client.Apps.ListDeployments(ctx, appID, &godo.ListOptions{Page: 1, PerPage: 1})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		if len(deployments) == 0 {
+			return mcp.NewToolResultText(fmt.Sprintf("there are no deployments found for AppID %s", appID)), nil
+		}
+		deploymentID = deployments[0].ID
+	}
+
+	// Filtering happens after downloading, so fetch more lines than requested to have enough left to match.
+	apiTail := tail
+	if filter != nil {
+		apiTail = maxLogTailLines
+	}
+	logs, _, err := a.client.Apps.GetLogs(ctx, appID, deploymentID, component, logType, false, apiTail)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	// Without a component the API returns one historic URL per component, so all of them are downloaded. When there
+	// are several, each line is labelled with the log it comes from.
+	urls := logs.HistoricURLs
+	if len(urls) == 0 && logs.LiveURL != "" {
+		urls = []string{logs.LiveURL}
+	}
+	if len(urls) == 0 {
+		return mcp.NewToolResultText(fmt.Sprintf("no %s logs available", typeName)), nil
+	}
+	var lines []string
+	total := 0
+	for i, url := range urls {
+		urlLines, err := fetchLogLines(ctx, url)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("log download error", err), nil
+		}
+		total += len(urlLines)
+		urlLines = filterLogLines(urlLines, filter, len(urlLines))
+		if len(urls) > 1 {
+			for j, line := range urlLines {
+				urlLines[j] = fmt.Sprintf("[log %d/%d] %s", i+1, len(urls), line)
+			}
+		}
+		lines = append(lines, urlLines...)
+	}
+	lines = filterLogLines(lines, nil, tail)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s logs", typeName)
+	if component != "" {
+		fmt.Fprintf(&b, " of component %s", component)
+	}
+	if deploymentID != "" {
+		fmt.Fprintf(&b, " for deployment %s", deploymentID)
+	}
+	if len(urls) > 1 {
+		fmt.Fprintf(&b, " from %d logs", len(urls))
+	}
+	if filter != nil {
+		fmt.Fprintf(&b, " (last %d of the lines matching %q out of %d lines)\n", len(lines), filter.String(), total)
+	} else {
+		fmt.Fprintf(&b, " (last %d lines)\n", len(lines))
+	}
+	if len(lines) == 0 {
+		b.WriteString("no log lines matched\n")
+	}
+	for _, line := range lines {
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+	return mcp.NewToolResultText(b.String()), nil
+}
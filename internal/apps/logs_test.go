@@ -0,0 +1,135 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFilterLogLines(t *testing.T) {
+	lines := []string{"starting", "error: one", "ok", "error: two", "error: three"}
+
+	require.Equal(t, []string{"error: two", "error: three"}, filterLogLines(lines, regexp.MustCompile("^error"), 2))
+	require.Equal(t, []string{"ok", "error: two", "error: three"}, filterLogLines(lines, nil, 3))
+	require.Empty(t, filterLogLines(lines, regexp.MustCompile("panic"), 10))
+}
+
+func TestGetLogs(t *testing.T) {
+	logServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/build":
+			fmt.Fprint(w, "Cloning repository\nnpm ERR! missing script: build\nBuild failed\n")
+		case "/build-api":
+			fmt.Fprint(w, "Cloning repository\ngo: module not found\nBuild failed\n")
+		case "/run":
+			fmt.Fprint(w, "listening on :8080\nGET / 200\nGET /health 200\n")
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer logServer.Close()
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		mock           func(app *MockAppsService)
+		expectError    bool
+		expectedText   []string
+		unexpectedText []string
+	}{
+		{
+			name: "Build logs of the most recent deployment",
+			args: map[string]any{"AppID": "app-123", "Type": "build", "Component": "web", "Filter": "err", "IgnoreCase": true},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().ListDeployments(gomock.Any(), "app-123", &godo.ListOptions{Page: 1, PerPage: 1}).
+					Return([]*godo.Deployment{{ID: "deploy-2"}}, nil, nil).Times(1)
+				app.EXPECT().GetLogs(gomock.Any(), "app-123", "deploy-2", "web", godo.AppLogTypeBuild, false, maxLogTailLines).
+					Return(&godo.AppLogs{HistoricURLs: []string{logServer.URL + "/build"}}, nil, nil).Times(1)
+			},
+			expectedText:   []string{"npm ERR! missing script: build", "deployment deploy-2"},
+			unexpectedText: []string{"Cloning repository", "Build failed"},
+		},
+		{
+			name: "Build logs of every component",
+			args: map[string]any{"AppID": "app-123", "Type": "build", "DeploymentID": "deploy-2", "Filter": "^Build failed$"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().GetLogs(gomock.Any(), "app-123", "deploy-2", "", godo.AppLogTypeBuild, false, maxLogTailLines).
+					Return(&godo.AppLogs{HistoricURLs: []string{logServer.URL + "/build", logServer.URL + "/build-api"}}, nil, nil).Times(1)
+			},
+			expectedText:   []string{"from 2 logs", "out of 6 lines", "[log 1/2] Build failed\n[log 2/2] Build failed\n"},
+			unexpectedText: []string{"npm ERR!", "go: module not found"},
+		},
+		{
+			name: "Run logs tailed",
+			args: map[string]any{"AppID": "app-123", "TailLines": float64(2)},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().GetLogs(gomock.Any(), "app-123", "", "", godo.AppLogTypeRun, false, 2).
+					Return(&godo.AppLogs{LiveURL: logServer.URL + "/run"}, nil, nil).Times(1)
+			},
+			expectedText:   []string{"GET / 200\nGET /health 200"},
+			unexpectedText: []string{"listening"},
+		},
+		{
+			name: "Download error",
+			args: map[string]any{"AppID": "app-123", "Type": "run_restarted"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().GetLogs(gomock.Any(), "app-123", "", "", godo.AppLogTypeRunRestarted, false, defaultLogTailLines).
+					Return(&godo.AppLogs{LiveURL: logServer.URL + "/expired"}, nil, nil).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"AppID": "app-123", "Type": "deploy", "DeploymentID": "deploy-1"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().GetLogs(gomock.Any(), "app-123", "deploy-1", "", godo.AppLogTypeDeploy, false, defaultLogTailLines).
+					Return(nil, nil, fmt.Errorf("api error")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Invalid filter",
+			args:        map[string]any{"AppID": "app-123", "Filter": "("},
+			expectError: true,
+		},
+		{
+			name:        "Invalid type",
+			args:        map[string]any{"AppID": "app-123", "Type": "access"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.getLogs(context.Background(), req)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			text := resp.Content[0].(mcp.TextContent).Text
+			for _, s := range tc.expectedText {
+				require.True(t, strings.Contains(text, s), "expected %q in %q", s, text)
+			}
+			for _, s := range tc.unexpectedText {
+				require.False(t, strings.Contains(text, s), "unexpected %q in %q", s, text)
+			}
+		})
+	}
+}
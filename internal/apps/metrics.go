@@ -0,0 +1,366 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+const (
+	// bytesPerGiB converts byte counts to GiB.
+	bytesPerGiB = 1 << 30
+	// daysPerMonth projects daily bandwidth onto a monthly allowance.
+	daysPerMonth = 30
+	// overProvisionedCPUPercent and overProvisionedMemoryPercent are the usage below which a component is
+	// considered over-provisioned.
+	overProvisionedCPUPercent    = 20
+	overProvisionedMemoryPercent = 30
+	// underProvisionedPercent is the CPU or memory usage from which a component is considered under-provisioned.
+	underProvisionedPercent = 80
+	// targetUtilization is the share of a smaller instance size the current usage may fill for it to be suggested.
+	targetUtilization = 0.7
+)
+
+// appBandwidthUsage is the bandwidth an app used on a day.
+type appBandwidthUsage struct {
+	AppID          string `json:"app_id"`
+	BandwidthBytes string `json:"bandwidth_bytes"`
+}
+
+// appBandwidthResponse is the response of the daily bandwidth endpoints.
+type appBandwidthResponse struct {
+	AppBandwidthUsage []*appBandwidthUsage `json:"app_bandwidth_usage"`
+	Date              time.Time            `json:"date"`
+}
+
+// appsBandwidthRequest is the request body of the daily bandwidth endpoint for several apps.
+type appsBandwidthRequest struct {
+	AppIDs []string `json:"app_ids"`
+	Date   string   `json:"date,omitempty"`
+}
+
+// bandwidthUsage is the daily bandwidth of an app in bytes and GiB.
+type bandwidthUsage struct {
+	AppID string  `json:"app_id"`
+	Bytes int64   `json:"bytes"`
+	GiB   float64 `json:"gib"`
+}
+
+// dailyBandwidth is the bandwidth of one or more apps on a day.
+type dailyBandwidth struct {
+	Date time.Time        `json:"date"`
+	Apps []bandwidthUsage `json:"apps"`
+}
+
+// componentResources is the size, usage and cost of a running component, with the issues found.
+type componentResources struct {
+	Component             string   `json:"component"`
+	Kind                  string   `json:"kind"`
+	State                 string   `json:"state"`
+	InstanceSize          string   `json:"instance_size"`
+	CPUs                  float64  `json:"cpus,omitempty"`
+	MemoryGiB             float64  `json:"memory_gib,omitempty"`
+	InstanceCount         int64    `json:"instance_count"`
+	ReplicasReady         int64    `json:"replicas_ready"`
+	ReplicasDesired       int64    `json:"replicas_desired"`
+	CPUUsagePercent       float64  `json:"cpu_usage_percent"`
+	MemoryUsagePercent    float64  `json:"memory_usage_percent"`
+	USDPerMonth           float64  `json:"usd_per_month"`
+	Flags                 []string `json:"flags"`
+	SuggestedInstanceSize string   `json:"suggested_instance_size,omitempty"`
+	SuggestedUSDPerMonth  float64  `json:"suggested_usd_per_month,omitempty"`
+}
+
+// bandwidthReport compares the bandwidth of an app to the allowance of its instances.
+type bandwidthReport struct {
+	Date                time.Time `json:"date"`
+	DailyGiB            float64   `json:"daily_gib"`
+	ProjectedMonthlyGiB float64   `json:"projected_monthly_gib"`
+	MonthlyAllowanceGiB float64   `json:"monthly_allowance_gib"`
+	OverAllowance       bool      `json:"over_allowance"`
+}
+
+// resourceReport is the per-component resource report of an app.
+type resourceReport struct {
+	AppID                    string               `json:"app_id"`
+	AppName                  string               `json:"app_name,omitempty"`
+	Components               []componentResources `json:"components"`
+	USDPerMonth              float64              `json:"usd_per_month"`
+	PotentialSavingsPerMonth float64              `json:"potential_savings_usd_per_month"`
+	Bandwidth                *bandwidthReport     `json:"bandwidth,omitempty"`
+	Notes                    []string             `json:"notes,omitempty"`
+}
+
+// parseBandwidthDate parses a YYYY-MM-DD date argument into the timestamp the bandwidth endpoints expect.
+func parseBandwidthDate(args map[string]any) (string, error) {
+	date, _ := args["Date"].(string)
+	if date == "" {
+		return "", nil
+	}
+	t, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return "", fmt.Errorf("date must be formatted as YYYY-MM-DD: %w", err)
+	}
+	return t.Format(time.RFC3339), nil
+}
+
+// summarizeBandwidth converts a bandwidth response to byte counts and GiB.
+func summarizeBandwidth(resp *appBandwidthResponse) (*dailyBandwidth, error) {
+	daily := &dailyBandwidth{Date: resp.Date, Apps: make([]bandwidthUsage, 0, len(resp.AppBandwidthUsage))}
+	for _, usage := range resp.AppBandwidthUsage {
+		bytes, err := strconv.ParseInt(usage.BandwidthBytes, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bandwidth %q for app %s: %w", usage.BandwidthBytes, usage.AppID, err)
+		}
+		daily.Apps = append(daily.Apps, bandwidthUsage{AppID: usage.AppID, Bytes: bytes, GiB: roundUSD(float64(bytes) / bytesPerGiB)})
+	}
+	return daily, nil
+}
+
+// getDailyBandwidth fetches the bandwidth of an app, or of several apps at once, on a day.
+func (a *AppPlatformTool) getDailyBandwidth(ctx context.Context, appIDs []string, date string) (*dailyBandwidth, error) {
+	resp := &appBandwidthResponse{}
+	if len(appIDs) == 1 {
+		path := fmt.Sprintf("/v2/apps/%s/metrics/bandwidth_daily", appIDs[0])
+		if date != "" {
+			path += "?date=" + url.QueryEscape(date)
+		}
+		if err := a.doAppsRequest(ctx, http.MethodGet, path, nil, resp); err != nil {
+			return nil, err
+		}
+	} else if err := a.doAppsRequest(ctx, http.MethodPost, "/v2/apps/metrics/bandwidth_daily", &appsBandwidthRequest{AppIDs: appIDs, Date: date}, resp); err != nil {
+		return nil, err
+	}
+	return summarizeBandwidth(resp)
+}
+
+// getBandwidth gets the daily bandwidth usage of one or more apps.
+func (a *AppPlatformTool) getBandwidth(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	var appIDs []string
+	if raw, ok := args["AppIDs"].([]any); ok {
+		for _, id := range raw {
+			if s, ok := id.(string); ok && s != "" {
+				appIDs = append(appIDs, s)
+			}
+		}
+	}
+	if len(appIDs) == 0 {
+		return mcp.NewToolResultError("At least one app ID is required"), nil
+	}
+	date, err := parseBandwidthDate(args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("invalid date", err), nil
+	}
+
+	daily, err := a.getDailyBandwidth(ctx, appIDs, date)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	dailyJSON, err := json.MarshalIndent(daily, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(dailyJSON)), nil
+}
+
+// instanceSizeCapacity is the parsed CPU count, memory and bandwidth allowance of an instance size.
+type instanceSizeCapacity struct {
+	size         *godo.AppInstanceSize
+	cpus         float64
+	memoryBytes  float64
+	price        float64
+	allowanceGiB float64
+}
+
+// parseInstanceSizes indexes instance sizes by slug with their capacity parsed.
+func parseInstanceSizes(sizes []*godo.AppInstanceSize) (map[string]instanceSizeCapacity, error) {
+	capacities := make(map[string]instanceSizeCapacity, len(sizes))
+	for _, size := range sizes {
+		price, err := instanceSizePrice(size)
+		if err != nil {
+			return nil, err
+		}
+		c := instanceSizeCapacity{size: size, price: price}
+		c.cpus, _ = strconv.ParseFloat(size.CPUs, 64)
+		c.memoryBytes, _ = strconv.ParseFloat(size.MemoryBytes, 64)
+		c.allowanceGiB, _ = strconv.ParseFloat(size.BandwidthAllowanceGib, 64)
+		capacities[size.Slug] = c
+	}
+	return capacities, nil
+}
+
+// smallerInstanceSize returns the cheapest instance size of the same CPU type that the measured usage would fill
+// to at most targetUtilization, if it is cheaper than the current one.
+func smallerInstanceSize(current instanceSizeCapacity, cpuPercent, memoryPercent float64, instances int64, capacities map[string]instanceSizeCapacity) (instanceSizeCapacity, bool) {
+	cpusUsed := current.cpus * cpuPercent / 100
+	memoryUsed := current.memoryBytes * memoryPercent / 100
+	var best instanceSizeCapacity
+	found := false
+	for _, c := range capacities {
+		if c.size.CPUType != current.size.CPUType || c.price >= current.price || c.size.DeprecationIntent {
+			continue
+		}
+		if instances > 1 && c.size.SingleInstanceOnly {
+			continue
+		}
+		if cpusUsed > c.cpus*targetUtilization || memoryUsed > c.memoryBytes*targetUtilization {
+			continue
+		}
+		if !found || c.price < best.price || c.price == best.price && c.size.Slug < best.size.Slug {
+			best, found = c, true
+		}
+	}
+	return best, found
+}
+
+// buildResourceReport combines the spec, health and bandwidth of an app into a per-component report, flagging
+// components whose instance size or count does not match their usage.
+func buildResourceReport(app *godo.App, health *godo.AppHealth, sizes []*godo.AppInstanceSize, bandwidth *dailyBandwidth) (*resourceReport, error) {
+	capacities, err := parseInstanceSizes(sizes)
+	if err != nil {
+		return nil, err
+	}
+	estimate, err := estimateSpecCost(app.Spec, sizes)
+	if err != nil {
+		return nil, err
+	}
+	healthByName := make(map[string]*godo.ComponentHealth)
+	if health != nil {
+		for _, c := range health.Components {
+			healthByName[c.Name] = c
+		}
+	}
+	autoscaled := make(map[string]bool)
+	for _, s := range app.Spec.Services {
+		autoscaled[s.Name] = s.Autoscaling != nil
+	}
+	for _, w := range app.Spec.Workers {
+		autoscaled[w.Name] = w.Autoscaling != nil
+	}
+
+	report := &resourceReport{AppID: app.ID, AppName: app.Spec.Name, Components: []componentResources{}, USDPerMonth: estimate.USDPerMonth}
+	allowanceGiB := 0.0
+	for _, cost := range estimate.Components {
+		// Jobs only run during deployments and the other kinds are not instance-sized, so they have no running usage.
+		if cost.Kind != "service" && cost.Kind != "worker" {
+			continue
+		}
+		capacity := capacities[cost.InstanceSize]
+		allowanceGiB += capacity.allowanceGiB * float64(cost.InstanceCount)
+		c := componentResources{
+			Component:     cost.Component,
+			Kind:          cost.Kind,
+			State:         string(godo.COMPONENTHEALTHSTATUS_Unknown),
+			InstanceSize:  cost.InstanceSize,
+			CPUs:          capacity.cpus,
+			MemoryGiB:     roundUSD(capacity.memoryBytes / bytesPerGiB),
+			InstanceCount: cost.InstanceCount,
+			USDPerMonth:   cost.USDPerMonth,
+			Flags:         []string{},
+		}
+		h, ok := healthByName[cost.Component]
+		if !ok {
+			c.Flags = append(c.Flags, "no health data")
+			report.Components = append(report.Components, c)
+			continue
+		}
+		c.State = string(h.State)
+		c.ReplicasReady, c.ReplicasDesired = h.ReplicasReady, h.ReplicasDesired
+		c.CPUUsagePercent = roundUSD(h.CPUUsagePercent)
+		c.MemoryUsagePercent = roundUSD(h.MemoryUsagePercent)
+
+		if h.State == godo.COMPONENTHEALTHSTATUS_Unhealthy {
+			c.Flags = append(c.Flags, "unhealthy")
+		}
+		if h.ReplicasReady < h.ReplicasDesired {
+			c.Flags = append(c.Flags, fmt.Sprintf("%d of %d instances ready", h.ReplicasReady, h.ReplicasDesired))
+		}
+		if h.ReplicasReady == 0 {
+			report.Components = append(report.Components, c)
+			continue
+		}
+		switch {
+		case h.CPUUsagePercent >= underProvisionedPercent || h.MemoryUsagePercent >= underProvisionedPercent:
+			c.Flags = append(c.Flags, "under-provisioned: consider a larger instance size or more instances")
+		case h.CPUUsagePercent < overProvisionedCPUPercent && h.MemoryUsagePercent < overProvisionedMemoryPercent:
+			if smaller, ok := smallerInstanceSize(capacity, h.CPUUsagePercent, h.MemoryUsagePercent, cost.InstanceCount, capacities); ok {
+				c.Flags = append(c.Flags, "over-provisioned: a smaller instance size fits the current usage")
+				c.SuggestedInstanceSize = smaller.size.Slug
+				c.SuggestedUSDPerMonth = roundUSD(smaller.price * float64(cost.InstanceCount))
+				report.PotentialSavingsPerMonth += c.USDPerMonth - c.SuggestedUSDPerMonth
+			} else if cost.InstanceCount > 1 && !autoscaled[cost.Component] {
+				c.Flags = append(c.Flags, "over-provisioned: fewer instances would handle the current load")
+			}
+		}
+		report.Components = append(report.Components, c)
+	}
+	report.PotentialSavingsPerMonth = roundUSD(report.PotentialSavingsPerMonth)
+	report.Notes = append(report.Notes, "CPU and memory usage are a snapshot of the current instances, not an average; check the trend before resizing.")
+
+	if bandwidth != nil {
+		for _, usage := range bandwidth.Apps {
+			if usage.AppID != app.ID {
+				continue
+			}
+			projected := usage.GiB * daysPerMonth
+			report.Bandwidth = &bandwidthReport{
+				Date:                bandwidth.Date,
+				DailyGiB:            usage.GiB,
+				ProjectedMonthlyGiB: roundUSD(projected),
+				MonthlyAllowanceGiB: roundUSD(allowanceGiB),
+				OverAllowance:       allowanceGiB > 0 && projected > allowanceGiB,
+			}
+			if report.Bandwidth.OverAllowance {
+				report.Notes = append(report.Notes, "At the daily rate, the app would exceed the bandwidth allowance of its instances this month; extra bandwidth is billed per GiB.")
+			}
+		}
+	}
+	return report, nil
+}
+
+// getResourceReport builds the resource report of an app from its spec, health, instance sizes and bandwidth.
+func (a *AppPlatformTool) getResourceReport(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	appID, ok := req.GetArguments()["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	if app.Spec == nil {
+		return mcp.NewToolResultError("The app has no spec"), nil
+	}
+	health, _, err := a.client.Apps.GetAppHealth(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	sizes, _, err := a.client.Apps.ListInstanceSizes(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	// Bandwidth is informative only, so the report is still returned when it is unavailable.
+	bandwidth, bandwidthErr := a.getDailyBandwidth(ctx, []string{appID}, "")
+
+	report, err := buildResourceReport(app, health, sizes, bandwidth)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("report failed", err), nil
+	}
+	if bandwidthErr != nil {
+		report.Notes = append(report.Notes, fmt.Sprintf("Bandwidth is not included: %v", bandwidthErr))
+	}
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(reportJSON)), nil
+}
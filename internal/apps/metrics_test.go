@@ -0,0 +1,205 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// newBandwidthServer serves the daily bandwidth endpoints, recording the requests it receives.
+func newBandwidthServer(t *testing.T, requests *[]string) *godo.Client {
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*requests = append(*requests, strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Method, r.URL.RequestURI(), body)))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/apps/app-123/metrics/bandwidth_daily":
+			fmt.Fprint(w, `{"app_bandwidth_usage": [{"app_id": "app-123", "bandwidth_bytes": "16106127360"}], "date": "2026-10-17T00:00:00Z"}`)
+		case "/v2/apps/metrics/bandwidth_daily":
+			fmt.Fprint(w, `{"app_bandwidth_usage": [{"app_id": "app-123", "bandwidth_bytes": "1073741824"}, {"app_id": "app-456", "bandwidth_bytes": "0"}], "date": "2026-10-17T00:00:00Z"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"id": "not_found", "message": "app not found"}`)
+		}
+	}))
+	t.Cleanup(apiServer.Close)
+
+	client, err := godo.New(apiServer.Client(), godo.SetBaseURL(apiServer.URL+"/"))
+	require.NoError(t, err)
+	return client
+}
+
+func TestGetBandwidth(t *testing.T) {
+	var requests []string
+	tool := &AppPlatformTool{client: newBandwidthServer(t, &requests)}
+
+	tests := []struct {
+		name            string
+		args            map[string]any
+		expectError     bool
+		expectedApps    []bandwidthUsage
+		expectedRequest string
+	}{
+		{
+			name:            "Single app on a day",
+			args:            map[string]any{"AppIDs": []any{"app-123"}, "Date": "2026-10-17"},
+			expectedApps:    []bandwidthUsage{{AppID: "app-123", Bytes: 16106127360, GiB: 15}},
+			expectedRequest: "GET /v2/apps/app-123/metrics/bandwidth_daily?date=2026-10-17T00%3A00%3A00Z",
+		},
+		{
+			name:            "Several apps",
+			args:            map[string]any{"AppIDs": []any{"app-123", "app-456"}},
+			expectedApps:    []bandwidthUsage{{AppID: "app-123", Bytes: 1073741824, GiB: 1}, {AppID: "app-456"}},
+			expectedRequest: `POST /v2/apps/metrics/bandwidth_daily {"app_ids":["app-123","app-456"]}`,
+		},
+		{
+			name:            "Unknown app",
+			args:            map[string]any{"AppIDs": []any{"app-789"}},
+			expectError:     true,
+			expectedRequest: "GET /v2/apps/app-789/metrics/bandwidth_daily",
+		},
+		{
+			name:        "Invalid date",
+			args:        map[string]any{"AppIDs": []any{"app-123"}, "Date": "17/10/2026"},
+			expectError: true,
+		},
+		{
+			name:        "No apps",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requests = nil
+			resp, err := tool.getBandwidth(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectedRequest != "" {
+				require.Equal(t, []string{tc.expectedRequest}, requests)
+			} else {
+				require.Empty(t, requests)
+			}
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var daily dailyBandwidth
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &daily))
+			require.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), daily.Date)
+			require.Equal(t, tc.expectedApps, daily.Apps)
+		})
+	}
+}
+
+func reportInstanceSizes() []*godo.AppInstanceSize {
+	return []*godo.AppInstanceSize{
+		{Slug: "apps-s-1vcpu-0.5gb", CPUType: godo.AppInstanceSizeCPUType_Shared, CPUs: "1", MemoryBytes: "536870912", USDPerMonth: "5.00", BandwidthAllowanceGib: "50", SingleInstanceOnly: true},
+		{Slug: "apps-s-1vcpu-1gb", CPUType: godo.AppInstanceSizeCPUType_Shared, CPUs: "1", MemoryBytes: "1073741824", USDPerMonth: "12.00", BandwidthAllowanceGib: "150"},
+		{Slug: "apps-s-2vcpu-4gb", CPUType: godo.AppInstanceSizeCPUType_Shared, CPUs: "2", MemoryBytes: "4294967296", USDPerMonth: "50.00", BandwidthAllowanceGib: "250"},
+		{Slug: "apps-d-1vcpu-4gb", CPUType: godo.AppInstanceSizeCPUType_Dedicated, CPUs: "1", MemoryBytes: "4294967296", USDPerMonth: "34.00", BandwidthAllowanceGib: "200"},
+	}
+}
+
+func TestBuildResourceReport(t *testing.T) {
+	app := &godo.App{
+		ID: "app-123",
+		Spec: &godo.AppSpec{
+			Name: "shop",
+			Services: []*godo.AppServiceSpec{
+				{Name: "web", InstanceSizeSlug: "apps-s-2vcpu-4gb", InstanceCount: 2},
+				{Name: "api", InstanceSizeSlug: "apps-s-1vcpu-1gb", InstanceCount: 1},
+				{Name: "admin", InstanceSizeSlug: "apps-s-1vcpu-1gb", InstanceCount: 3},
+			},
+			Workers:     []*godo.AppWorkerSpec{{Name: "queue", InstanceSizeSlug: "apps-s-1vcpu-1gb"}},
+			Jobs:        []*godo.AppJobSpec{{Name: "migrate", InstanceSizeSlug: "apps-s-1vcpu-1gb"}},
+			StaticSites: []*godo.AppStaticSiteSpec{{Name: "docs"}},
+		},
+	}
+	health := &godo.AppHealth{Components: []*godo.ComponentHealth{
+		{Name: "web", State: godo.COMPONENTHEALTHSTATUS_Healthy, CPUUsagePercent: 5, MemoryUsagePercent: 10, ReplicasDesired: 2, ReplicasReady: 2},
+		{Name: "api", State: godo.COMPONENTHEALTHSTATUS_Unhealthy, CPUUsagePercent: 92.5, MemoryUsagePercent: 40, ReplicasDesired: 1, ReplicasReady: 1},
+		{Name: "admin", State: godo.COMPONENTHEALTHSTATUS_Healthy, CPUUsagePercent: 10, MemoryUsagePercent: 25, ReplicasDesired: 3, ReplicasReady: 2},
+	}}
+	bandwidth := &dailyBandwidth{Date: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), Apps: []bandwidthUsage{{AppID: "app-123", Bytes: 48318382080, GiB: 45}}}
+
+	report, err := buildResourceReport(app, health, reportInstanceSizes(), bandwidth)
+	require.NoError(t, err)
+	require.Equal(t, []componentResources{
+		{
+			Component: "web", Kind: "service", State: "HEALTHY", InstanceSize: "apps-s-2vcpu-4gb", CPUs: 2, MemoryGiB: 4, InstanceCount: 2,
+			ReplicasReady: 2, ReplicasDesired: 2, CPUUsagePercent: 5, MemoryUsagePercent: 10, USDPerMonth: 100,
+			Flags:                 []string{"over-provisioned: a smaller instance size fits the current usage"},
+			SuggestedInstanceSize: "apps-s-1vcpu-1gb", SuggestedUSDPerMonth: 24,
+		},
+		{
+			Component: "api", Kind: "service", State: "UNHEALTHY", InstanceSize: "apps-s-1vcpu-1gb", CPUs: 1, MemoryGiB: 1, InstanceCount: 1,
+			ReplicasReady: 1, ReplicasDesired: 1, CPUUsagePercent: 92.5, MemoryUsagePercent: 40, USDPerMonth: 12,
+			Flags: []string{"unhealthy", "under-provisioned: consider a larger instance size or more instances"},
+		},
+		{
+			Component: "admin", Kind: "service", State: "HEALTHY", InstanceSize: "apps-s-1vcpu-1gb", CPUs: 1, MemoryGiB: 1, InstanceCount: 3,
+			ReplicasReady: 2, ReplicasDesired: 3, CPUUsagePercent: 10, MemoryUsagePercent: 25, USDPerMonth: 36,
+			Flags: []string{"2 of 3 instances ready", "over-provisioned: fewer instances would handle the current load"},
+		},
+		{
+			Component: "queue", Kind: "worker", State: "UNKNOWN", InstanceSize: "apps-s-1vcpu-1gb", CPUs: 1, MemoryGiB: 1, InstanceCount: 1,
+			USDPerMonth: 12, Flags: []string{"no health data"},
+		},
+	}, report.Components)
+	require.Equal(t, 160.0, report.USDPerMonth)
+	require.Equal(t, 76.0, report.PotentialSavingsPerMonth)
+	require.Equal(t, &bandwidthReport{
+		Date:                bandwidth.Date,
+		DailyGiB:            45,
+		ProjectedMonthlyGiB: 1350,
+		MonthlyAllowanceGiB: 1250,
+		OverAllowance:       true,
+	}, report.Bandwidth)
+	require.Len(t, report.Notes, 2)
+}
+
+func TestGetResourceReport(t *testing.T) {
+	var requests []string
+	client := newBandwidthServer(t, &requests)
+	ctrl := gomock.NewController(t)
+	appService := NewMockAppsService(ctrl)
+	client.Apps = appService
+	tool := &AppPlatformTool{client: client}
+
+	app := &godo.App{ID: "app-123", Spec: &godo.AppSpec{Name: "shop", Services: []*godo.AppServiceSpec{{Name: "web", InstanceSizeSlug: "apps-s-1vcpu-1gb"}}}}
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(app, nil, nil).Times(1)
+	appService.EXPECT().GetAppHealth(gomock.Any(), "app-123").Return(&godo.AppHealth{Components: []*godo.ComponentHealth{
+		{Name: "web", State: godo.COMPONENTHEALTHSTATUS_Healthy, CPUUsagePercent: 50, MemoryUsagePercent: 50, ReplicasDesired: 1, ReplicasReady: 1},
+	}}, nil, nil).Times(1)
+	appService.EXPECT().ListInstanceSizes(gomock.Any()).Return(reportInstanceSizes(), nil, nil).Times(1)
+
+	resp, err := tool.getResourceReport(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, []string{"GET /v2/apps/app-123/metrics/bandwidth_daily"}, requests)
+	var report resourceReport
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &report))
+	require.Len(t, report.Components, 1)
+	require.Empty(t, report.Components[0].Flags)
+	require.Equal(t, 15.0, report.Bandwidth.DailyGiB)
+	require.Equal(t, 150.0, report.Bandwidth.MonthlyAllowanceGiB)
+	require.True(t, report.Bandwidth.OverAllowance)
+
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(nil, nil, fmt.Errorf("api error")).Times(1)
+	resp, err = tool.getResourceReport(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/digitalocean/godo (interfaces: AppsService)
+// Source: github.com/digitalocean/godo (interfaces: AppsService,DomainsService)
 //
 // Generated by this command:
 //
-//	mockgen -destination=./mocks.go -package apps github.com/digitalocean/godo AppsService
+//	mockgen -destination=./mocks.go -package apps github.com/digitalocean/godo AppsService,DomainsService
 //
 
 // Package apps is a generated GoMock package.
@@ -21,6 +21,7 @@ import (
 type MockAppsService struct {
 	ctrl     *gomock.Controller
 	recorder *MockAppsServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockAppsServiceMockRecorder is the mock recorder for MockAppsService.
@@ -41,9 +42,9 @@ func (m *MockAppsService) EXPECT() *MockAppsServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockAppsService) Create(arg0 context.Context, arg1 *godo.AppCreateRequest) (*godo.App, *godo.Response, error) {
+func (m *MockAppsService) Create(ctx context.Context, create *godo.AppCreateRequest) (*godo.App, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret := m.ctrl.Call(m, "Create", ctx, create)
 	ret0, _ := ret[0].(*godo.App)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -51,16 +52,16 @@ func (m *MockAppsService) Create(arg0 context.Context, arg1 *godo.AppCreateReque
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockAppsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) Create(ctx, create any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAppsService)(nil).Create), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAppsService)(nil).Create), ctx, create)
 }
 
 // CreateDeployment mocks base method.
-func (m *MockAppsService) CreateDeployment(arg0 context.Context, arg1 string, arg2 ...*godo.DeploymentCreateRequest) (*godo.Deployment, *godo.Response, error) {
+func (m *MockAppsService) CreateDeployment(ctx context.Context, appID string, create ...*godo.DeploymentCreateRequest) (*godo.Deployment, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{arg0, arg1}
-	for _, a := range arg2 {
+	varargs := []any{ctx, appID}
+	for _, a := range create {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CreateDeployment", varargs...)
@@ -71,31 +72,31 @@ func (m *MockAppsService) CreateDeployment(arg0 context.Context, arg1 string, ar
 }
 
 // CreateDeployment indicates an expected call of CreateDeployment.
-func (mr *MockAppsServiceMockRecorder) CreateDeployment(arg0, arg1 any, arg2 ...any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) CreateDeployment(ctx, appID any, create ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{arg0, arg1}, arg2...)
+	varargs := append([]any{ctx, appID}, create...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeployment", reflect.TypeOf((*MockAppsService)(nil).CreateDeployment), varargs...)
 }
 
 // Delete mocks base method.
-func (m *MockAppsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+func (m *MockAppsService) Delete(ctx context.Context, appID string) (*godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret := m.ctrl.Call(m, "Delete", ctx, appID)
 	ret0, _ := ret[0].(*godo.Response)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockAppsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) Delete(ctx, appID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAppsService)(nil).Delete), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAppsService)(nil).Delete), ctx, appID)
 }
 
 // Detect mocks base method.
-func (m *MockAppsService) Detect(arg0 context.Context, arg1 *godo.DetectRequest) (*godo.DetectResponse, *godo.Response, error) {
+func (m *MockAppsService) Detect(ctx context.Context, detect *godo.DetectRequest) (*godo.DetectResponse, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Detect", arg0, arg1)
+	ret := m.ctrl.Call(m, "Detect", ctx, detect)
 	ret0, _ := ret[0].(*godo.DetectResponse)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -103,15 +104,15 @@ func (m *MockAppsService) Detect(arg0 context.Context, arg1 *godo.DetectRequest)
 }
 
 // Detect indicates an expected call of Detect.
-func (mr *MockAppsServiceMockRecorder) Detect(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) Detect(ctx, detect any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MockAppsService)(nil).Detect), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MockAppsService)(nil).Detect), ctx, detect)
 }
 
 // Get mocks base method.
-func (m *MockAppsService) Get(arg0 context.Context, arg1 string) (*godo.App, *godo.Response, error) {
+func (m *MockAppsService) Get(ctx context.Context, appID string) (*godo.App, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret := m.ctrl.Call(m, "Get", ctx, appID)
 	ret0, _ := ret[0].(*godo.App)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -119,15 +120,15 @@ func (m *MockAppsService) Get(arg0 context.Context, arg1 string) (*godo.App, *go
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockAppsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) Get(ctx, appID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAppsService)(nil).Get), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAppsService)(nil).Get), ctx, appID)
 }
 
 // GetAppDatabaseConnectionDetails mocks base method.
-func (m *MockAppsService) GetAppDatabaseConnectionDetails(arg0 context.Context, arg1 string) ([]*godo.GetDatabaseConnectionDetailsResponse, *godo.Response, error) {
+func (m *MockAppsService) GetAppDatabaseConnectionDetails(ctx context.Context, appID string) ([]*godo.GetDatabaseConnectionDetailsResponse, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppDatabaseConnectionDetails", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetAppDatabaseConnectionDetails", ctx, appID)
 	ret0, _ := ret[0].([]*godo.GetDatabaseConnectionDetailsResponse)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -135,15 +136,15 @@ func (m *MockAppsService) GetAppDatabaseConnectionDetails(arg0 context.Context,
 }
 
 // GetAppDatabaseConnectionDetails indicates an expected call of GetAppDatabaseConnectionDetails.
-func (mr *MockAppsServiceMockRecorder) GetAppDatabaseConnectionDetails(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetAppDatabaseConnectionDetails(ctx, appID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppDatabaseConnectionDetails", reflect.TypeOf((*MockAppsService)(nil).GetAppDatabaseConnectionDetails), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppDatabaseConnectionDetails", reflect.TypeOf((*MockAppsService)(nil).GetAppDatabaseConnectionDetails), ctx, appID)
 }
 
 // GetAppHealth mocks base method.
-func (m *MockAppsService) GetAppHealth(arg0 context.Context, arg1 string) (*godo.AppHealth, *godo.Response, error) {
+func (m *MockAppsService) GetAppHealth(ctx context.Context, appID string) (*godo.AppHealth, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppHealth", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetAppHealth", ctx, appID)
 	ret0, _ := ret[0].(*godo.AppHealth)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -151,15 +152,15 @@ func (m *MockAppsService) GetAppHealth(arg0 context.Context, arg1 string) (*godo
 }
 
 // GetAppHealth indicates an expected call of GetAppHealth.
-func (mr *MockAppsServiceMockRecorder) GetAppHealth(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetAppHealth(ctx, appID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppHealth", reflect.TypeOf((*MockAppsService)(nil).GetAppHealth), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppHealth", reflect.TypeOf((*MockAppsService)(nil).GetAppHealth), ctx, appID)
 }
 
 // GetAppInstances mocks base method.
-func (m *MockAppsService) GetAppInstances(arg0 context.Context, arg1 string, arg2 *godo.GetAppInstancesOpts) ([]*godo.AppInstance, *godo.Response, error) {
+func (m *MockAppsService) GetAppInstances(ctx context.Context, appID string, opts *godo.GetAppInstancesOpts) ([]*godo.AppInstance, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAppInstances", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetAppInstances", ctx, appID, opts)
 	ret0, _ := ret[0].([]*godo.AppInstance)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -167,15 +168,15 @@ func (m *MockAppsService) GetAppInstances(arg0 context.Context, arg1 string, arg
 }
 
 // GetAppInstances indicates an expected call of GetAppInstances.
-func (mr *MockAppsServiceMockRecorder) GetAppInstances(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetAppInstances(ctx, appID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppInstances", reflect.TypeOf((*MockAppsService)(nil).GetAppInstances), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppInstances", reflect.TypeOf((*MockAppsService)(nil).GetAppInstances), ctx, appID, opts)
 }
 
 // GetDeployment mocks base method.
-func (m *MockAppsService) GetDeployment(arg0 context.Context, arg1, arg2 string) (*godo.Deployment, *godo.Response, error) {
+func (m *MockAppsService) GetDeployment(ctx context.Context, appID, deploymentID string) (*godo.Deployment, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDeployment", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "GetDeployment", ctx, appID, deploymentID)
 	ret0, _ := ret[0].(*godo.Deployment)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -183,15 +184,15 @@ func (m *MockAppsService) GetDeployment(arg0 context.Context, arg1, arg2 string)
 }
 
 // GetDeployment indicates an expected call of GetDeployment.
-func (mr *MockAppsServiceMockRecorder) GetDeployment(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetDeployment(ctx, appID, deploymentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockAppsService)(nil).GetDeployment), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockAppsService)(nil).GetDeployment), ctx, appID, deploymentID)
 }
 
 // GetExec mocks base method.
-func (m *MockAppsService) GetExec(arg0 context.Context, arg1, arg2, arg3 string) (*godo.AppExec, *godo.Response, error) {
+func (m *MockAppsService) GetExec(ctx context.Context, appID, deploymentID, component string) (*godo.AppExec, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExec", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetExec", ctx, appID, deploymentID, component)
 	ret0, _ := ret[0].(*godo.AppExec)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -199,15 +200,15 @@ func (m *MockAppsService) GetExec(arg0 context.Context, arg1, arg2, arg3 string)
 }
 
 // GetExec indicates an expected call of GetExec.
-func (mr *MockAppsServiceMockRecorder) GetExec(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetExec(ctx, appID, deploymentID, component any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExec", reflect.TypeOf((*MockAppsService)(nil).GetExec), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExec", reflect.TypeOf((*MockAppsService)(nil).GetExec), ctx, appID, deploymentID, component)
 }
 
 // GetExecWithOpts mocks base method.
-func (m *MockAppsService) GetExecWithOpts(arg0 context.Context, arg1, arg2 string, arg3 *godo.AppGetExecOptions) (*godo.AppExec, *godo.Response, error) {
+func (m *MockAppsService) GetExecWithOpts(ctx context.Context, appID, componentName string, opts *godo.AppGetExecOptions) (*godo.AppExec, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExecWithOpts", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "GetExecWithOpts", ctx, appID, componentName, opts)
 	ret0, _ := ret[0].(*godo.AppExec)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -215,15 +216,15 @@ func (m *MockAppsService) GetExecWithOpts(arg0 context.Context, arg1, arg2 strin
 }
 
 // GetExecWithOpts indicates an expected call of GetExecWithOpts.
-func (mr *MockAppsServiceMockRecorder) GetExecWithOpts(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetExecWithOpts(ctx, appID, componentName, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecWithOpts", reflect.TypeOf((*MockAppsService)(nil).GetExecWithOpts), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecWithOpts", reflect.TypeOf((*MockAppsService)(nil).GetExecWithOpts), ctx, appID, componentName, opts)
 }
 
 // GetInstanceSize mocks base method.
-func (m *MockAppsService) GetInstanceSize(arg0 context.Context, arg1 string) (*godo.AppInstanceSize, *godo.Response, error) {
+func (m *MockAppsService) GetInstanceSize(ctx context.Context, slug string) (*godo.AppInstanceSize, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetInstanceSize", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetInstanceSize", ctx, slug)
 	ret0, _ := ret[0].(*godo.AppInstanceSize)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -231,15 +232,15 @@ func (m *MockAppsService) GetInstanceSize(arg0 context.Context, arg1 string) (*g
 }
 
 // GetInstanceSize indicates an expected call of GetInstanceSize.
-func (mr *MockAppsServiceMockRecorder) GetInstanceSize(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetInstanceSize(ctx, slug any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceSize", reflect.TypeOf((*MockAppsService)(nil).GetInstanceSize), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceSize", reflect.TypeOf((*MockAppsService)(nil).GetInstanceSize), ctx, slug)
 }
 
 // GetLogs mocks base method.
-func (m *MockAppsService) GetLogs(arg0 context.Context, arg1, arg2, arg3 string, arg4 godo.AppLogType, arg5 bool, arg6 int) (*godo.AppLogs, *godo.Response, error) {
+func (m *MockAppsService) GetLogs(ctx context.Context, appID, deploymentID, component string, logType godo.AppLogType, follow bool, tailLines int) (*godo.AppLogs, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLogs", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	ret := m.ctrl.Call(m, "GetLogs", ctx, appID, deploymentID, component, logType, follow, tailLines)
 	ret0, _ := ret[0].(*godo.AppLogs)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -247,15 +248,15 @@ func (m *MockAppsService) GetLogs(arg0 context.Context, arg1, arg2, arg3 string,
 }
 
 // GetLogs indicates an expected call of GetLogs.
-func (mr *MockAppsServiceMockRecorder) GetLogs(arg0, arg1, arg2, arg3, arg4, arg5, arg6 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetLogs(ctx, appID, deploymentID, component, logType, follow, tailLines any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockAppsService)(nil).GetLogs), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockAppsService)(nil).GetLogs), ctx, appID, deploymentID, component, logType, follow, tailLines)
 }
 
 // GetTier mocks base method.
-func (m *MockAppsService) GetTier(arg0 context.Context, arg1 string) (*godo.AppTier, *godo.Response, error) {
+func (m *MockAppsService) GetTier(ctx context.Context, slug string) (*godo.AppTier, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTier", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetTier", ctx, slug)
 	ret0, _ := ret[0].(*godo.AppTier)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -263,15 +264,15 @@ func (m *MockAppsService) GetTier(arg0 context.Context, arg1 string) (*godo.AppT
 }
 
 // GetTier indicates an expected call of GetTier.
-func (mr *MockAppsServiceMockRecorder) GetTier(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) GetTier(ctx, slug any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTier", reflect.TypeOf((*MockAppsService)(nil).GetTier), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTier", reflect.TypeOf((*MockAppsService)(nil).GetTier), ctx, slug)
 }
 
 // List mocks base method.
-func (m *MockAppsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]*godo.App, *godo.Response, error) {
+func (m *MockAppsService) List(ctx context.Context, opts *godo.ListOptions) ([]*godo.App, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret := m.ctrl.Call(m, "List", ctx, opts)
 	ret0, _ := ret[0].([]*godo.App)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -279,15 +280,15 @@ func (m *MockAppsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]
 }
 
 // List indicates an expected call of List.
-func (mr *MockAppsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) List(ctx, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAppsService)(nil).List), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAppsService)(nil).List), ctx, opts)
 }
 
 // ListAlerts mocks base method.
-func (m *MockAppsService) ListAlerts(arg0 context.Context, arg1 string) ([]*godo.AppAlert, *godo.Response, error) {
+func (m *MockAppsService) ListAlerts(ctx context.Context, appID string) ([]*godo.AppAlert, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAlerts", arg0, arg1)
+	ret := m.ctrl.Call(m, "ListAlerts", ctx, appID)
 	ret0, _ := ret[0].([]*godo.AppAlert)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -295,15 +296,15 @@ func (m *MockAppsService) ListAlerts(arg0 context.Context, arg1 string) ([]*godo
 }
 
 // ListAlerts indicates an expected call of ListAlerts.
-func (mr *MockAppsServiceMockRecorder) ListAlerts(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ListAlerts(ctx, appID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockAppsService)(nil).ListAlerts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockAppsService)(nil).ListAlerts), ctx, appID)
 }
 
 // ListBuildpacks mocks base method.
-func (m *MockAppsService) ListBuildpacks(arg0 context.Context) ([]*godo.Buildpack, *godo.Response, error) {
+func (m *MockAppsService) ListBuildpacks(ctx context.Context) ([]*godo.Buildpack, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBuildpacks", arg0)
+	ret := m.ctrl.Call(m, "ListBuildpacks", ctx)
 	ret0, _ := ret[0].([]*godo.Buildpack)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -311,15 +312,15 @@ func (m *MockAppsService) ListBuildpacks(arg0 context.Context) ([]*godo.Buildpac
 }
 
 // ListBuildpacks indicates an expected call of ListBuildpacks.
-func (mr *MockAppsServiceMockRecorder) ListBuildpacks(arg0 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ListBuildpacks(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildpacks", reflect.TypeOf((*MockAppsService)(nil).ListBuildpacks), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuildpacks", reflect.TypeOf((*MockAppsService)(nil).ListBuildpacks), ctx)
 }
 
 // ListDeployments mocks base method.
-func (m *MockAppsService) ListDeployments(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]*godo.Deployment, *godo.Response, error) {
+func (m *MockAppsService) ListDeployments(ctx context.Context, appID string, opts *godo.ListOptions) ([]*godo.Deployment, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDeployments", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ListDeployments", ctx, appID, opts)
 	ret0, _ := ret[0].([]*godo.Deployment)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -327,15 +328,15 @@ func (m *MockAppsService) ListDeployments(arg0 context.Context, arg1 string, arg
 }
 
 // ListDeployments indicates an expected call of ListDeployments.
-func (mr *MockAppsServiceMockRecorder) ListDeployments(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ListDeployments(ctx, appID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployments", reflect.TypeOf((*MockAppsService)(nil).ListDeployments), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeployments", reflect.TypeOf((*MockAppsService)(nil).ListDeployments), ctx, appID, opts)
 }
 
 // ListInstanceSizes mocks base method.
-func (m *MockAppsService) ListInstanceSizes(arg0 context.Context) ([]*godo.AppInstanceSize, *godo.Response, error) {
+func (m *MockAppsService) ListInstanceSizes(ctx context.Context) ([]*godo.AppInstanceSize, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListInstanceSizes", arg0)
+	ret := m.ctrl.Call(m, "ListInstanceSizes", ctx)
 	ret0, _ := ret[0].([]*godo.AppInstanceSize)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -343,15 +344,15 @@ func (m *MockAppsService) ListInstanceSizes(arg0 context.Context) ([]*godo.AppIn
 }
 
 // ListInstanceSizes indicates an expected call of ListInstanceSizes.
-func (mr *MockAppsServiceMockRecorder) ListInstanceSizes(arg0 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ListInstanceSizes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceSizes", reflect.TypeOf((*MockAppsService)(nil).ListInstanceSizes), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceSizes", reflect.TypeOf((*MockAppsService)(nil).ListInstanceSizes), ctx)
 }
 
 // ListRegions mocks base method.
-func (m *MockAppsService) ListRegions(arg0 context.Context) ([]*godo.AppRegion, *godo.Response, error) {
+func (m *MockAppsService) ListRegions(ctx context.Context) ([]*godo.AppRegion, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRegions", arg0)
+	ret := m.ctrl.Call(m, "ListRegions", ctx)
 	ret0, _ := ret[0].([]*godo.AppRegion)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -359,15 +360,15 @@ func (m *MockAppsService) ListRegions(arg0 context.Context) ([]*godo.AppRegion,
 }
 
 // ListRegions indicates an expected call of ListRegions.
-func (mr *MockAppsServiceMockRecorder) ListRegions(arg0 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ListRegions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockAppsService)(nil).ListRegions), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockAppsService)(nil).ListRegions), ctx)
 }
 
 // ListTiers mocks base method.
-func (m *MockAppsService) ListTiers(arg0 context.Context) ([]*godo.AppTier, *godo.Response, error) {
+func (m *MockAppsService) ListTiers(ctx context.Context) ([]*godo.AppTier, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTiers", arg0)
+	ret := m.ctrl.Call(m, "ListTiers", ctx)
 	ret0, _ := ret[0].([]*godo.AppTier)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -375,15 +376,15 @@ func (m *MockAppsService) ListTiers(arg0 context.Context) ([]*godo.AppTier, *god
 }
 
 // ListTiers indicates an expected call of ListTiers.
-func (mr *MockAppsServiceMockRecorder) ListTiers(arg0 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ListTiers(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTiers", reflect.TypeOf((*MockAppsService)(nil).ListTiers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTiers", reflect.TypeOf((*MockAppsService)(nil).ListTiers), ctx)
 }
 
 // Propose mocks base method.
-func (m *MockAppsService) Propose(arg0 context.Context, arg1 *godo.AppProposeRequest) (*godo.AppProposeResponse, *godo.Response, error) {
+func (m *MockAppsService) Propose(ctx context.Context, propose *godo.AppProposeRequest) (*godo.AppProposeResponse, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Propose", arg0, arg1)
+	ret := m.ctrl.Call(m, "Propose", ctx, propose)
 	ret0, _ := ret[0].(*godo.AppProposeResponse)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -391,15 +392,15 @@ func (m *MockAppsService) Propose(arg0 context.Context, arg1 *godo.AppProposeReq
 }
 
 // Propose indicates an expected call of Propose.
-func (mr *MockAppsServiceMockRecorder) Propose(arg0, arg1 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) Propose(ctx, propose any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Propose", reflect.TypeOf((*MockAppsService)(nil).Propose), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Propose", reflect.TypeOf((*MockAppsService)(nil).Propose), ctx, propose)
 }
 
 // ResetDatabasePassword mocks base method.
-func (m *MockAppsService) ResetDatabasePassword(arg0 context.Context, arg1, arg2 string) (*godo.Deployment, *godo.Response, error) {
+func (m *MockAppsService) ResetDatabasePassword(ctx context.Context, appID, component string) (*godo.Deployment, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ResetDatabasePassword", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ResetDatabasePassword", ctx, appID, component)
 	ret0, _ := ret[0].(*godo.Deployment)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -407,15 +408,15 @@ func (m *MockAppsService) ResetDatabasePassword(arg0 context.Context, arg1, arg2
 }
 
 // ResetDatabasePassword indicates an expected call of ResetDatabasePassword.
-func (mr *MockAppsServiceMockRecorder) ResetDatabasePassword(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ResetDatabasePassword(ctx, appID, component any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDatabasePassword", reflect.TypeOf((*MockAppsService)(nil).ResetDatabasePassword), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDatabasePassword", reflect.TypeOf((*MockAppsService)(nil).ResetDatabasePassword), ctx, appID, component)
 }
 
 // Restart mocks base method.
-func (m *MockAppsService) Restart(arg0 context.Context, arg1 string, arg2 *godo.AppRestartRequest) (*godo.Deployment, *godo.Response, error) {
+func (m *MockAppsService) Restart(ctx context.Context, appID string, opts *godo.AppRestartRequest) (*godo.Deployment, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Restart", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Restart", ctx, appID, opts)
 	ret0, _ := ret[0].(*godo.Deployment)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -423,15 +424,15 @@ func (m *MockAppsService) Restart(arg0 context.Context, arg1 string, arg2 *godo.
 }
 
 // Restart indicates an expected call of Restart.
-func (mr *MockAppsServiceMockRecorder) Restart(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) Restart(ctx, appID, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockAppsService)(nil).Restart), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restart", reflect.TypeOf((*MockAppsService)(nil).Restart), ctx, appID, opts)
 }
 
 // ToggleDatabaseTrustedSource mocks base method.
-func (m *MockAppsService) ToggleDatabaseTrustedSource(arg0 context.Context, arg1, arg2 string, arg3 godo.ToggleDatabaseTrustedSourceOptions) (*godo.ToggleDatabaseTrustedSourceResponse, *godo.Response, error) {
+func (m *MockAppsService) ToggleDatabaseTrustedSource(ctx context.Context, appID, component string, opts godo.ToggleDatabaseTrustedSourceOptions) (*godo.ToggleDatabaseTrustedSourceResponse, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToggleDatabaseTrustedSource", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "ToggleDatabaseTrustedSource", ctx, appID, component, opts)
 	ret0, _ := ret[0].(*godo.ToggleDatabaseTrustedSourceResponse)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -439,15 +440,15 @@ func (m *MockAppsService) ToggleDatabaseTrustedSource(arg0 context.Context, arg1
 }
 
 // ToggleDatabaseTrustedSource indicates an expected call of ToggleDatabaseTrustedSource.
-func (mr *MockAppsServiceMockRecorder) ToggleDatabaseTrustedSource(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) ToggleDatabaseTrustedSource(ctx, appID, component, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleDatabaseTrustedSource", reflect.TypeOf((*MockAppsService)(nil).ToggleDatabaseTrustedSource), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToggleDatabaseTrustedSource", reflect.TypeOf((*MockAppsService)(nil).ToggleDatabaseTrustedSource), ctx, appID, component, opts)
 }
 
 // Update mocks base method.
-func (m *MockAppsService) Update(arg0 context.Context, arg1 string, arg2 *godo.AppUpdateRequest) (*godo.App, *godo.Response, error) {
+func (m *MockAppsService) Update(ctx context.Context, appID string, update *godo.AppUpdateRequest) (*godo.App, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Update", ctx, appID, update)
 	ret0, _ := ret[0].(*godo.App)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -455,15 +456,15 @@ func (m *MockAppsService) Update(arg0 context.Context, arg1 string, arg2 *godo.A
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockAppsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) Update(ctx, appID, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAppsService)(nil).Update), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAppsService)(nil).Update), ctx, appID, update)
 }
 
 // UpdateAlertDestinations mocks base method.
-func (m *MockAppsService) UpdateAlertDestinations(arg0 context.Context, arg1, arg2 string, arg3 *godo.AlertDestinationUpdateRequest) (*godo.AppAlert, *godo.Response, error) {
+func (m *MockAppsService) UpdateAlertDestinations(ctx context.Context, appID, alertID string, update *godo.AlertDestinationUpdateRequest) (*godo.AppAlert, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAlertDestinations", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "UpdateAlertDestinations", ctx, appID, alertID, update)
 	ret0, _ := ret[0].(*godo.AppAlert)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -471,15 +472,15 @@ func (m *MockAppsService) UpdateAlertDestinations(arg0 context.Context, arg1, ar
 }
 
 // UpdateAlertDestinations indicates an expected call of UpdateAlertDestinations.
-func (mr *MockAppsServiceMockRecorder) UpdateAlertDestinations(arg0, arg1, arg2, arg3 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) UpdateAlertDestinations(ctx, appID, alertID, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertDestinations", reflect.TypeOf((*MockAppsService)(nil).UpdateAlertDestinations), arg0, arg1, arg2, arg3)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertDestinations", reflect.TypeOf((*MockAppsService)(nil).UpdateAlertDestinations), ctx, appID, alertID, update)
 }
 
 // UpgradeBuildpack mocks base method.
-func (m *MockAppsService) UpgradeBuildpack(arg0 context.Context, arg1 string, arg2 godo.UpgradeBuildpackOptions) (*godo.UpgradeBuildpackResponse, *godo.Response, error) {
+func (m *MockAppsService) UpgradeBuildpack(ctx context.Context, appID string, opts godo.UpgradeBuildpackOptions) (*godo.UpgradeBuildpackResponse, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpgradeBuildpack", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpgradeBuildpack", ctx, appID, opts)
 	ret0, _ := ret[0].(*godo.UpgradeBuildpackResponse)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -487,7 +488,221 @@ func (m *MockAppsService) UpgradeBuildpack(arg0 context.Context, arg1 string, ar
 }
 
 // UpgradeBuildpack indicates an expected call of UpgradeBuildpack.
-func (mr *MockAppsServiceMockRecorder) UpgradeBuildpack(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockAppsServiceMockRecorder) UpgradeBuildpack(ctx, appID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeBuildpack", reflect.TypeOf((*MockAppsService)(nil).UpgradeBuildpack), ctx, appID, opts)
+}
+
+// MockDomainsService is a mock of DomainsService interface.
+type MockDomainsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainsServiceMockRecorder is the mock recorder for MockDomainsService.
+type MockDomainsServiceMockRecorder struct {
+	mock *MockDomainsService
+}
+
+// NewMockDomainsService creates a new mock instance.
+func NewMockDomainsService(ctrl *gomock.Controller) *MockDomainsService {
+	mock := &MockDomainsService{ctrl: ctrl}
+	mock.recorder = &MockDomainsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainsService) EXPECT() *MockDomainsServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDomainsService) Create(arg0 context.Context, arg1 *godo.DomainCreateRequest) (*godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDomainsServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDomainsService)(nil).Create), arg0, arg1)
+}
+
+// CreateRecord mocks base method.
+func (m *MockDomainsService) CreateRecord(arg0 context.Context, arg1 string, arg2 *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateRecord indicates an expected call of CreateRecord.
+func (mr *MockDomainsServiceMockRecorder) CreateRecord(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecord", reflect.TypeOf((*MockDomainsService)(nil).CreateRecord), arg0, arg1, arg2)
+}
+
+// Delete mocks base method.
+func (m *MockDomainsService) Delete(arg0 context.Context, arg1 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDomainsServiceMockRecorder) Delete(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDomainsService)(nil).Delete), arg0, arg1)
+}
+
+// DeleteRecord mocks base method.
+func (m *MockDomainsService) DeleteRecord(arg0 context.Context, arg1 string, arg2 int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecord", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecord indicates an expected call of DeleteRecord.
+func (mr *MockDomainsServiceMockRecorder) DeleteRecord(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecord", reflect.TypeOf((*MockDomainsService)(nil).DeleteRecord), arg0, arg1, arg2)
+}
+
+// EditRecord mocks base method.
+func (m *MockDomainsService) EditRecord(arg0 context.Context, arg1 string, arg2 int, arg3 *godo.DomainRecordEditRequest) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditRecord", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// EditRecord indicates an expected call of EditRecord.
+func (mr *MockDomainsServiceMockRecorder) EditRecord(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditRecord", reflect.TypeOf((*MockDomainsService)(nil).EditRecord), arg0, arg1, arg2, arg3)
+}
+
+// Get mocks base method.
+func (m *MockDomainsService) Get(arg0 context.Context, arg1 string) (*godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDomainsServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDomainsService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockDomainsService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.Domain, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.Domain)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockDomainsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDomainsService)(nil).List), arg0, arg1)
+}
+
+// Record mocks base method.
+func (m *MockDomainsService) Record(arg0 context.Context, arg1 string, arg2 int) (*godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockDomainsServiceMockRecorder) Record(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockDomainsService)(nil).Record), arg0, arg1, arg2)
+}
+
+// Records mocks base method.
+func (m *MockDomainsService) Records(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Records", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Records indicates an expected call of Records.
+func (mr *MockDomainsServiceMockRecorder) Records(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Records", reflect.TypeOf((*MockDomainsService)(nil).Records), arg0, arg1, arg2)
+}
+
+// RecordsByName mocks base method.
+func (m *MockDomainsService) RecordsByName(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByName", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByName indicates an expected call of RecordsByName.
+func (mr *MockDomainsServiceMockRecorder) RecordsByName(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByName", reflect.TypeOf((*MockDomainsService)(nil).RecordsByName), arg0, arg1, arg2, arg3)
+}
+
+// RecordsByType mocks base method.
+func (m *MockDomainsService) RecordsByType(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByType", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByType indicates an expected call of RecordsByType.
+func (mr *MockDomainsServiceMockRecorder) RecordsByType(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByType", reflect.TypeOf((*MockDomainsService)(nil).RecordsByType), arg0, arg1, arg2, arg3)
+}
+
+// RecordsByTypeAndName mocks base method.
+func (m *MockDomainsService) RecordsByTypeAndName(arg0 context.Context, arg1, arg2, arg3 string, arg4 *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordsByTypeAndName", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]godo.DomainRecord)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RecordsByTypeAndName indicates an expected call of RecordsByTypeAndName.
+func (mr *MockDomainsServiceMockRecorder) RecordsByTypeAndName(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeBuildpack", reflect.TypeOf((*MockAppsService)(nil).UpgradeBuildpack), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordsByTypeAndName", reflect.TypeOf((*MockDomainsService)(nil).RecordsByTypeAndName), arg0, arg1, arg2, arg3, arg4)
 }
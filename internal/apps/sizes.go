@@ -0,0 +1,273 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// defaultInstanceSizeSlug is the instance size App Platform uses for components that do not set one.
+const defaultInstanceSizeSlug = "apps-s-1vcpu-0.5gb"
+
+// componentCost is the estimated monthly cost of a single app component.
+type componentCost struct {
+	Component        string  `json:"component"`
+	Kind             string  `json:"kind"`
+	InstanceSize     string  `json:"instance_size,omitempty"`
+	InstanceCount    int64   `json:"instance_count,omitempty"`
+	MaxInstanceCount int64   `json:"max_instance_count,omitempty"`
+	USDPerInstance   float64 `json:"usd_per_instance,omitempty"`
+	USDPerMonth      float64 `json:"usd_per_month"`
+	MaxUSDPerMonth   float64 `json:"max_usd_per_month,omitempty"`
+}
+
+// costEstimate is the estimated monthly cost of an app spec.
+type costEstimate struct {
+	AppName        string          `json:"app_name,omitempty"`
+	Components     []componentCost `json:"components"`
+	USDPerMonth    float64         `json:"usd_per_month"`
+	MaxUSDPerMonth float64         `json:"max_usd_per_month,omitempty"`
+	Notes          []string        `json:"notes,omitempty"`
+}
+
+// instanceSizePrice parses the monthly price of an instance size.
+func instanceSizePrice(size *godo.AppInstanceSize) (float64, error) {
+	price, err := strconv.ParseFloat(size.USDPerMonth, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid price %q for instance size %s: %w", size.USDPerMonth, size.Slug, err)
+	}
+	return price, nil
+}
+
+// roundUSD rounds an amount in USD to cents.
+func roundUSD(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// estimateSpecCost prices the always-on components of a spec (services and workers) with the given instance sizes.
+// Jobs, billed for their runtime, and components billed otherwise are listed at no monthly cost with a note.
+func estimateSpecCost(spec *godo.AppSpec, sizes []*godo.AppInstanceSize) (*costEstimate, error) {
+	prices := make(map[string]float64, len(sizes))
+	for _, size := range sizes {
+		price, err := instanceSizePrice(size)
+		if err != nil {
+			return nil, err
+		}
+		prices[size.Slug] = price
+	}
+
+	estimate := &costEstimate{AppName: spec.Name, Components: []componentCost{}}
+	usedDefault := false
+	add := func(name, kind, slug string, count int64, autoscaling *godo.AppAutoscalingSpec) error {
+		if slug == "" {
+			slug = defaultInstanceSizeSlug
+			usedDefault = true
+		}
+		price, ok := prices[slug]
+		if !ok {
+			return fmt.Errorf("unknown instance size %q for component %s", slug, name)
+		}
+		cost := componentCost{Component: name, Kind: kind, InstanceSize: slug, USDPerInstance: price}
+		if autoscaling != nil && autoscaling.MaxInstanceCount > 0 {
+			cost.InstanceCount = max(autoscaling.MinInstanceCount, 1)
+			cost.MaxInstanceCount = autoscaling.MaxInstanceCount
+			cost.MaxUSDPerMonth = roundUSD(price * float64(cost.MaxInstanceCount))
+		} else {
+			cost.InstanceCount = max(count, 1)
+		}
+		cost.USDPerMonth = roundUSD(price * float64(cost.InstanceCount))
+		estimate.Components = append(estimate.Components, cost)
+		return nil
+	}
+
+	for _, s := range spec.Services {
+		if err := add(s.Name, "service", s.InstanceSizeSlug, s.InstanceCount, s.Autoscaling); err != nil {
+			return nil, err
+		}
+	}
+	for _, w := range spec.Workers {
+		if err := add(w.Name, "worker", w.InstanceSizeSlug, w.InstanceCount, w.Autoscaling); err != nil {
+			return nil, err
+		}
+	}
+	for _, j := range spec.Jobs {
+		if err := add(j.Name, "job", j.InstanceSizeSlug, j.InstanceCount, nil); err != nil {
+			return nil, err
+		}
+		// Jobs only run with deployments or on a schedule and are billed for their runtime, not per month.
+		estimate.Components[len(estimate.Components)-1].USDPerMonth = 0
+	}
+	for _, s := range spec.StaticSites {
+		estimate.Components = append(estimate.Components, componentCost{Component: s.Name, Kind: "static_site"})
+	}
+	for _, f := range spec.Functions {
+		estimate.Components = append(estimate.Components, componentCost{Component: f.Name, Kind: "function"})
+	}
+	for _, d := range spec.Databases {
+		estimate.Components = append(estimate.Components, componentCost{Component: d.Name, Kind: "database"})
+	}
+
+	autoscaled := false
+	for _, c := range estimate.Components {
+		estimate.USDPerMonth += c.USDPerMonth
+		if c.MaxInstanceCount > 0 {
+			autoscaled = true
+			estimate.MaxUSDPerMonth += c.MaxUSDPerMonth
+		} else {
+			estimate.MaxUSDPerMonth += c.USDPerMonth
+		}
+	}
+	estimate.USDPerMonth = roundUSD(estimate.USDPerMonth)
+	if autoscaled {
+		estimate.MaxUSDPerMonth = roundUSD(estimate.MaxUSDPerMonth)
+		estimate.Notes = append(estimate.Notes, "Autoscaled components are priced at their minimum instance count; max_usd_per_month assumes every autoscaled component runs at its maximum.")
+	} else {
+		estimate.MaxUSDPerMonth = 0
+	}
+	if usedDefault {
+		estimate.Notes = append(estimate.Notes, fmt.Sprintf("Components without an instance size are priced as %s, the App Platform default.", defaultInstanceSizeSlug))
+	}
+	if len(spec.Jobs) > 0 {
+		estimate.Notes = append(estimate.Notes, "Jobs are not included: they are billed for the time they run at the price of their instance size, not per month.")
+	}
+	if len(spec.StaticSites) > 0 || len(spec.Functions) > 0 {
+		estimate.Notes = append(estimate.Notes, "Static sites and functions are not included: static sites are free for the first starter apps of an account and functions are billed by usage.")
+	}
+	if len(spec.Databases) > 0 {
+		estimate.Notes = append(estimate.Notes, "Databases are not included: dev databases have a fixed monthly price and managed database clusters are billed separately.")
+	}
+	return estimate, nil
+}
+
+// listInstanceSizes lists the instance sizes available to app components, cheapest first.
+func (a *AppPlatformTool) listInstanceSizes(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	cpuType, _ := req.GetArguments()["CPUType"].(string)
+	cpuType = strings.ToUpper(cpuType)
+	if cpuType != "" && cpuType != string(godo.AppInstanceSizeCPUType_Shared) && cpuType != string(godo.AppInstanceSizeCPUType_Dedicated) {
+		return mcp.NewToolResultError("CPUType must be SHARED or DEDICATED"), nil
+	}
+
+	sizes, _, err := a.client.Apps.ListInstanceSizes(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	filtered := make([]*godo.AppInstanceSize, 0, len(sizes))
+	for _, size := range sizes {
+		if cpuType == "" || string(size.CPUType) == cpuType {
+			filtered = append(filtered, size)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		pi, _ := strconv.ParseFloat(filtered[i].USDPerMonth, 64)
+		pj, _ := strconv.ParseFloat(filtered[j].USDPerMonth, 64)
+		return pi < pj
+	})
+
+	sizesJSON, err := json.MarshalIndent(filtered, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(sizesJSON)), nil
+}
+
+// getInstanceSize gets a single instance size by its slug.
+func (a *AppPlatformTool) getInstanceSize(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	slug, ok := req.GetArguments()["Slug"].(string)
+	if !ok || slug == "" {
+		return mcp.NewToolResultError("Instance size slug is required"), nil
+	}
+
+	size, _, err := a.client.Apps.GetInstanceSize(ctx, slug)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	sizeJSON, err := json.MarshalIndent(size, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(sizeJSON)), nil
+}
+
+// listTiers lists the App Platform tiers.
+func (a *AppPlatformTool) listTiers(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	tiers, _, err := a.client.Apps.ListTiers(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	tiersJSON, err := json.MarshalIndent(tiers, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(tiersJSON)), nil
+}
+
+// listRegions lists the regions apps can be deployed to.
+func (a *AppPlatformTool) listRegions(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	regions, _, err := a.client.Apps.ListRegions(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	regionsJSON, err := json.MarshalIndent(regions, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(regionsJSON)), nil
+}
+
+// estimateCost estimates the monthly cost of an app spec per component. The spec is given inline, taken from an
+// existing app, or taken from a proposal made with apps-spec-propose.
+func (a *AppPlatformTool) estimateCost(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	rawSpec, hasSpec := args["Spec"].(map[string]any)
+	appID, _ := args["AppID"].(string)
+	proposalID, _ := args["ProposalID"].(string)
+
+	var spec *godo.AppSpec
+	switch {
+	case hasSpec && (appID != "" || proposalID != ""), appID != "" && proposalID != "":
+		return mcp.NewToolResultError("Provide only one of Spec, AppID or ProposalID"), nil
+	case hasSpec:
+		var err error
+		if spec, err = decodeSpec(rawSpec); err != nil {
+			return mcp.NewToolResultErrorFromErr("invalid spec", err), nil
+		}
+	case appID != "":
+		app, _, err := a.client.Apps.Get(ctx, appID)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		spec = app.Spec
+	case proposalID != "":
+		proposal, ok := a.proposals.get(proposalID)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("proposal %s not found or expired; propose the change again", proposalID)), nil
+		}
+		spec = proposal.Spec
+	default:
+		return mcp.NewToolResultError("One of Spec, AppID or ProposalID is required"), nil
+	}
+	if spec == nil {
+		return mcp.NewToolResultError("The app has no spec"), nil
+	}
+
+	sizes, _, err := a.client.Apps.ListInstanceSizes(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	estimate, err := estimateSpecCost(spec, sizes)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("cost estimation failed", err), nil
+	}
+	estimateJSON, err := json.MarshalIndent(estimate, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(estimateJSON)), nil
+}
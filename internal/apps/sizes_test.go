@@ -0,0 +1,178 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testInstanceSizes() []*godo.AppInstanceSize {
+	return []*godo.AppInstanceSize{
+		{Slug: "apps-d-1vcpu-1gb", CPUType: godo.AppInstanceSizeCPUType_Dedicated, USDPerMonth: "29.00", Scalable: true},
+		{Slug: "apps-s-1vcpu-1gb", CPUType: godo.AppInstanceSizeCPUType_Shared, USDPerMonth: "12.00"},
+		{Slug: "apps-s-1vcpu-0.5gb", CPUType: godo.AppInstanceSizeCPUType_Shared, USDPerMonth: "5.00", SingleInstanceOnly: true},
+	}
+}
+
+func TestListInstanceSizes(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().ListInstanceSizes(gomock.Any()).Return(testInstanceSizes(), nil, nil).Times(2)
+
+	resp, err := tool.listInstanceSizes(context.Background(), mcp.CallToolRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var sizes []*godo.AppInstanceSize
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &sizes))
+	require.Len(t, sizes, 3)
+	require.Equal(t, "apps-s-1vcpu-0.5gb", sizes[0].Slug)
+	require.Equal(t, "apps-d-1vcpu-1gb", sizes[2].Slug)
+
+	resp, err = tool.listInstanceSizes(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"CPUType": "dedicated"}}})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &sizes))
+	require.Len(t, sizes, 1)
+	require.Equal(t, "apps-d-1vcpu-1gb", sizes[0].Slug)
+
+	resp, err = tool.listInstanceSizes(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"CPUType": "GPU"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestGetInstanceSize(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().GetInstanceSize(gomock.Any(), "apps-s-1vcpu-1gb").Return(testInstanceSizes()[1], nil, nil).Times(1)
+	appService.EXPECT().GetInstanceSize(gomock.Any(), "basic-xxl").Return(nil, nil, fmt.Errorf("not found")).Times(1)
+
+	resp, err := tool.getInstanceSize(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Slug": "apps-s-1vcpu-1gb"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Contains(t, resp.Content[0].(mcp.TextContent).Text, `"usd_per_month": "12.00"`)
+
+	resp, err = tool.getInstanceSize(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Slug": "basic-xxl"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestEstimateSpecCost(t *testing.T) {
+	spec := &godo.AppSpec{
+		Name: "shop",
+		Services: []*godo.AppServiceSpec{
+			{Name: "web", InstanceSizeSlug: "apps-s-1vcpu-1gb", InstanceCount: 2},
+			{Name: "api", InstanceSizeSlug: "apps-d-1vcpu-1gb", Autoscaling: &godo.AppAutoscalingSpec{MinInstanceCount: 2, MaxInstanceCount: 5}},
+		},
+		Workers:     []*godo.AppWorkerSpec{{Name: "queue"}},
+		Jobs:        []*godo.AppJobSpec{{Name: "migrate", InstanceSizeSlug: "apps-s-1vcpu-1gb", Kind: godo.AppJobSpecKind_PreDeploy}},
+		StaticSites: []*godo.AppStaticSiteSpec{{Name: "docs"}},
+	}
+
+	estimate, err := estimateSpecCost(spec, testInstanceSizes())
+	require.NoError(t, err)
+	require.Equal(t, []componentCost{
+		{Component: "web", Kind: "service", InstanceSize: "apps-s-1vcpu-1gb", InstanceCount: 2, USDPerInstance: 12, USDPerMonth: 24},
+		{Component: "api", Kind: "service", InstanceSize: "apps-d-1vcpu-1gb", InstanceCount: 2, MaxInstanceCount: 5, USDPerInstance: 29, USDPerMonth: 58, MaxUSDPerMonth: 145},
+		{Component: "queue", Kind: "worker", InstanceSize: "apps-s-1vcpu-0.5gb", InstanceCount: 1, USDPerInstance: 5, USDPerMonth: 5},
+		{Component: "migrate", Kind: "job", InstanceSize: "apps-s-1vcpu-1gb", InstanceCount: 1, USDPerInstance: 12},
+		{Component: "docs", Kind: "static_site"},
+	}, estimate.Components)
+	require.Equal(t, 87.0, estimate.USDPerMonth)
+	require.Equal(t, 174.0, estimate.MaxUSDPerMonth)
+	require.Len(t, estimate.Notes, 4)
+
+	spec.Workers[0].InstanceSizeSlug = "basic-xxl"
+	_, err = estimateSpecCost(spec, testInstanceSizes())
+	require.EqualError(t, err, `unknown instance size "basic-xxl" for component queue`)
+}
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          map[string]any
+		mock          func(app *MockAppsService)
+		expectError   bool
+		expectedTotal float64
+	}{
+		{
+			name: "Inline spec",
+			args: map[string]any{"Spec": map[string]any{
+				"name":     "shop",
+				"services": []any{map[string]any{"name": "web", "instance_size_slug": "apps-s-1vcpu-1gb", "instance_count": 3}},
+			}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().ListInstanceSizes(gomock.Any()).Return(testInstanceSizes(), nil, nil).Times(1)
+			},
+			expectedTotal: 36,
+		},
+		{
+			name: "Existing app",
+			args: map[string]any{"AppID": "app-123"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{Spec: &godo.AppSpec{Workers: []*godo.AppWorkerSpec{{Name: "queue", InstanceSizeSlug: "apps-s-1vcpu-1gb"}}}}, nil, nil).Times(1)
+				app.EXPECT().ListInstanceSizes(gomock.Any()).Return(testInstanceSizes(), nil, nil).Times(1)
+			},
+			expectedTotal: 12,
+		},
+		{
+			name: "Proposal",
+			args: map[string]any{"ProposalID": "proposal-1"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().ListInstanceSizes(gomock.Any()).Return(testInstanceSizes(), nil, nil).Times(1)
+			},
+			expectedTotal: 29,
+		},
+		{
+			name:        "Unknown spec field",
+			args:        map[string]any{"Spec": map[string]any{"servces": []any{}}},
+			expectError: true,
+		},
+		{
+			name:        "Spec and app",
+			args:        map[string]any{"Spec": map[string]any{"name": "shop"}, "AppID": "app-123"},
+			expectError: true,
+		},
+		{
+			name:        "Expired proposal",
+			args:        map[string]any{"ProposalID": "proposal-2"},
+			expectError: true,
+		},
+		{
+			name:        "Nothing to estimate",
+			args:        map[string]any{},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			tool.proposals.put(&specProposal{
+				ID:        "proposal-1",
+				AppID:     "app-123",
+				Spec:      &godo.AppSpec{Services: []*godo.AppServiceSpec{{Name: "api", InstanceSizeSlug: "apps-d-1vcpu-1gb"}}},
+				ExpiresAt: time.Now().Add(time.Hour),
+			})
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			resp, err := tool.estimateCost(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			var estimate costEstimate
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &estimate))
+			require.Equal(t, tc.expectedTotal, estimate.USDPerMonth)
+		})
+	}
+}
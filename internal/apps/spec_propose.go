@@ -0,0 +1,468 @@
+package apps
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// proposalTTL is how long a spec proposal can be applied after it was made.
+const proposalTTL = time.Hour
+
+// specProposal is a validated spec change waiting to be applied with apps-update.
+type specProposal struct {
+	ID        string
+	AppID     string
+	Spec      *godo.AppSpec
+	BaseHash  string
+	ExpiresAt time.Time
+}
+
+// proposalStore keeps the spec proposals in memory. The zero value is ready to use.
+type proposalStore struct {
+	mu        sync.Mutex
+	proposals map[string]*specProposal
+}
+
+// put stores a proposal, dropping the expired ones.
+func (s *proposalStore) put(p *specProposal) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.proposals == nil {
+		s.proposals = map[string]*specProposal{}
+	}
+	now := time.Now()
+	for id, existing := range s.proposals {
+		if now.After(existing.ExpiresAt) {
+			delete(s.proposals, id)
+		}
+	}
+	s.proposals[p.ID] = p
+}
+
+// get returns an unexpired proposal.
+func (s *proposalStore) get(id string) (*specProposal, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.proposals[id]
+	if !ok || time.Now().After(p.ExpiresAt) {
+		return nil, false
+	}
+	return p, true
+}
+
+// remove deletes a proposal once it has been applied.
+func (s *proposalStore) remove(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.proposals, id)
+}
+
+// specChange is a single difference between the current and the proposed spec.
+type specChange struct {
+	Path   string `json:"path"`
+	Op     string `json:"op"`
+	Before any    `json:"before,omitempty"`
+	After  any    `json:"after,omitempty"`
+}
+
+// specCost is the monthly cost of the current and the proposed spec in USD.
+type specCost struct {
+	Current  float64 `json:"current"`
+	Proposed float64 `json:"proposed"`
+	Change   float64 `json:"change"`
+}
+
+// specProposalResult is the output of the propose tool. Secret environment variable values are redacted.
+type specProposalResult struct {
+	ProposalID string        `json:"proposal_id"`
+	AppID      string        `json:"app_id"`
+	ExpiresAt  time.Time     `json:"expires_at"`
+	Changes    []specChange  `json:"changes"`
+	Cost       specCost      `json:"cost"`
+	Spec       *godo.AppSpec `json:"spec"`
+}
+
+// specListKeys are the fields identifying the elements of spec lists, so list changes are reported per element
+// (e.g. services[web].envs[API_KEY]) instead of per index.
+var specListKeys = []string{"name", "key", "domain", "component_name", "rule"}
+
+// specHash fingerprints a spec so a proposal is only applied to the spec it was made against.
+func specHash(spec *godo.AppSpec) (string, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// toJSONValue converts a value to its generic JSON representation.
+func toJSONValue(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// mergePatch applies a JSON merge patch (RFC 7396) to a JSON value.
+func mergePatch(target, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	targetObj, ok := target.(map[string]any)
+	if !ok {
+		targetObj = map[string]any{}
+	}
+	result := make(map[string]any, len(targetObj))
+	for k, v := range targetObj {
+		result[k] = v
+	}
+	for k, v := range patchObj {
+		if v == nil {
+			delete(result, k)
+			continue
+		}
+		result[k] = mergePatch(result[k], v)
+	}
+	return result
+}
+
+// decodeSpec decodes a generic JSON value into an app spec, rejecting unknown fields so typos are not silently
+// dropped.
+func decodeSpec(v any) (*godo.AppSpec, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	spec := &godo.AppSpec{}
+	if err := decoder.Decode(spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// listElementKey returns the field uniquely identifying the elements of both lists, if there is one.
+func listElementKey(before, after []any) (string, bool) {
+	for _, key := range specListKeys {
+		if hasUniqueKey(before, key) && hasUniqueKey(after, key) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// hasUniqueKey reports whether all elements of a list are objects with a distinct string value for key.
+func hasUniqueKey(items []any, key string) bool {
+	seen := map[string]bool{}
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+		v, ok := obj[key].(string)
+		if !ok || seen[v] {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
+
+// diffSpecValues appends the differences between two generic JSON values.
+func diffSpecValues(path string, before, after any, changes *[]specChange) {
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	switch {
+	case before == nil:
+		*changes = append(*changes, specChange{Path: path, Op: "added", After: redactSecrets(after)})
+		return
+	case after == nil:
+		*changes = append(*changes, specChange{Path: path, Op: "removed", Before: redactSecrets(before)})
+		return
+	}
+
+	beforeObj, beforeIsObj := before.(map[string]any)
+	afterObj, afterIsObj := after.(map[string]any)
+	if beforeIsObj && afterIsObj {
+		keys := make([]string, 0, len(beforeObj)+len(afterObj))
+		for k := range beforeObj {
+			keys = append(keys, k)
+		}
+		for k := range afterObj {
+			if _, ok := beforeObj[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		secret := isSecretEnv(beforeObj) || isSecretEnv(afterObj)
+		for _, k := range keys {
+			if secret && k == "value" {
+				diffSecretValues(joinSpecPath(path, k), beforeObj[k], afterObj[k], changes)
+				continue
+			}
+			diffSpecValues(joinSpecPath(path, k), beforeObj[k], afterObj[k], changes)
+		}
+		return
+	}
+
+	beforeList, beforeIsList := before.([]any)
+	afterList, afterIsList := after.([]any)
+	if beforeIsList && afterIsList {
+		if key, ok := listElementKey(beforeList, afterList); ok {
+			diffSpecLists(path, key, beforeList, afterList, changes)
+			return
+		}
+	}
+	*changes = append(*changes, specChange{Path: path, Op: "changed", Before: redactSecrets(before), After: redactSecrets(after)})
+}
+
+// diffSecretValues appends the change of a secret environment variable value, without the values themselves.
+func diffSecretValues(path string, before, after any, changes *[]specChange) {
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	change := specChange{Path: path, Op: "changed"}
+	if before != nil {
+		change.Before = redactedValue
+	} else {
+		change.Op = "added"
+	}
+	if after != nil {
+		change.After = redactedValue
+	} else {
+		change.Op = "removed"
+	}
+	*changes = append(*changes, change)
+}
+
+// isSecretEnv reports whether a generic JSON object is a secret environment variable.
+func isSecretEnv(obj map[string]any) bool {
+	return obj["type"] == string(godo.AppVariableType_Secret)
+}
+
+// redactSecrets returns a copy of a generic JSON value with the values of secret environment variables redacted,
+// the same way redactEnvVar does.
+func redactSecrets(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, item := range v {
+			out[k] = redactSecrets(item)
+		}
+		if _, ok := out["value"]; ok && isSecretEnv(out) {
+			out["value"] = redactedValue
+		}
+		return out
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = redactSecrets(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// redactSpec returns a copy of an app spec with the values of secret environment variables redacted.
+func redactSpec(spec *godo.AppSpec) (*godo.AppSpec, error) {
+	v, err := toJSONValue(spec)
+	if err != nil {
+		return nil, err
+	}
+	return decodeSpec(redactSecrets(v))
+}
+
+// diffSpecLists diffs two lists whose elements are identified by key.
+func diffSpecLists(path, key string, before, after []any, changes *[]specChange) {
+	beforeByKey := map[string]any{}
+	var order []string
+	for _, item := range before {
+		k := item.(map[string]any)[key].(string)
+		beforeByKey[k] = item
+		order = append(order, k)
+	}
+	afterByKey := map[string]any{}
+	for _, item := range after {
+		k := item.(map[string]any)[key].(string)
+		afterByKey[k] = item
+		if _, ok := beforeByKey[k]; !ok {
+			order = append(order, k)
+		}
+	}
+	for _, k := range order {
+		diffSpecValues(fmt.Sprintf("%s[%s]", path, k), beforeByKey[k], afterByKey[k], changes)
+	}
+}
+
+// joinSpecPath appends a field to a spec path.
+func joinSpecPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// diffSpecs lists the differences between two app specs.
+func diffSpecs(before, after *godo.AppSpec) ([]specChange, error) {
+	beforeValue, err := toJSONValue(before)
+	if err != nil {
+		return nil, err
+	}
+	afterValue, err := toJSONValue(after)
+	if err != nil {
+		return nil, err
+	}
+	changes := []specChange{}
+	diffSpecValues("", beforeValue, afterValue, &changes)
+	return changes, nil
+}
+
+// newProposalID generates a random proposal ID.
+func newProposalID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "proposal-" + hex.EncodeToString(b), nil
+}
+
+// roundCost rounds a cost in USD to cents.
+func roundCost(v float32) float64 {
+	return roundUSD(float64(v))
+}
+
+// proposeSpec applies a JSON merge patch to the current spec of an app, validates the result and returns the diff
+// and cost change as a proposal that apps-update can apply.
+func (a *AppPlatformTool) proposeSpec(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	patch, ok := args["Patch"].(map[string]any)
+	if !ok || len(patch) == 0 {
+		return mcp.NewToolResultError("Patch is required"), nil
+	}
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	current, err := toJSONValue(app.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	proposed, err := decodeSpec(mergePatch(current, patch))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("invalid patch", err), nil
+	}
+
+	changes, err := diffSpecs(app.Spec, proposed)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	if len(changes) == 0 {
+		return mcp.NewToolResultText("The patch does not change the app spec"), nil
+	}
+
+	// Proposing the current spec too gives the cost baseline, which the app itself does not expose.
+	currentProposal, _, err := a.client.Apps.Propose(ctx, &godo.AppProposeRequest{Spec: app.Spec, AppID: appID})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	proposal, _, err := a.client.Apps.Propose(ctx, &godo.AppProposeRequest{Spec: proposed, AppID: appID})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("spec validation failed", err), nil
+	}
+
+	baseHash, err := specHash(app.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	id, err := newProposalID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate proposal ID: %w", err)
+	}
+	stored := &specProposal{ID: id, AppID: appID, Spec: proposed, BaseHash: baseHash, ExpiresAt: time.Now().Add(proposalTTL)}
+	a.proposals.put(stored)
+
+	// The stored proposal keeps the secret values so it can be applied; the output never shows them.
+	redacted, err := redactSpec(proposed)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	result := specProposalResult{
+		ProposalID: id,
+		AppID:      appID,
+		ExpiresAt:  stored.ExpiresAt,
+		Changes:    changes,
+		Cost: specCost{
+			Current:  roundCost(currentProposal.AppCost),
+			Proposed: roundCost(proposal.AppCost),
+			Change:   roundCost(proposal.AppCost - currentProposal.AppCost),
+		},
+		Spec: redacted,
+	}
+	resultJSON, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(resultJSON)), nil
+}
+
+// applyProposal applies a spec proposal, provided the app spec has not changed since the proposal was made.
+func (a *AppPlatformTool) applyProposal(ctx context.Context, appID, proposalID string) (*mcp.CallToolResult, error) {
+	proposal, ok := a.proposals.get(proposalID)
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("proposal %s not found or expired; propose the change again", proposalID)), nil
+	}
+	if appID != "" && appID != proposal.AppID {
+		return mcp.NewToolResultError(fmt.Sprintf("proposal %s is for app %s, not %s", proposalID, proposal.AppID, appID)), nil
+	}
+
+	app, _, err := a.client.Apps.Get(ctx, proposal.AppID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	currentHash, err := specHash(app.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	if currentHash != proposal.BaseHash {
+		return mcp.NewToolResultError(fmt.Sprintf("the spec of app %s changed since proposal %s was made; propose the change again", proposal.AppID, proposalID)), nil
+	}
+
+	updated, _, err := a.client.Apps.Update(ctx, proposal.AppID, &godo.AppUpdateRequest{Spec: proposal.Spec})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	a.proposals.remove(proposalID)
+
+	appJSON, err := json.MarshalIndent(updated, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(appJSON)), nil
+}
@@ -0,0 +1,257 @@
+package apps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testAppSpec() *godo.AppSpec {
+	return &godo.AppSpec{
+		Name:   "shop",
+		Region: "nyc",
+		Services: []*godo.AppServiceSpec{
+			{
+				Name:             "web",
+				InstanceSizeSlug: "apps-s-1vcpu-0.5gb",
+				InstanceCount:    1,
+				Envs: []*godo.AppVariableDefinition{
+					{Key: "LOG_LEVEL", Value: "info"},
+					{Key: "API_KEY", Value: "EV[1:abc]", Type: godo.AppVariableType_Secret},
+				},
+			},
+			{Name: "api", InstanceSizeSlug: "apps-s-1vcpu-1gb", InstanceCount: 1},
+		},
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	target := map[string]any{"name": "shop", "region": "nyc", "alerts": []any{"x"}, "ingress": map[string]any{"rules": []any{}}}
+	patch := map[string]any{"region": "ams", "alerts": nil, "ingress": map[string]any{"loadbalancer": "DIGITALOCEAN"}}
+
+	require.Equal(t, map[string]any{
+		"name":    "shop",
+		"region":  "ams",
+		"ingress": map[string]any{"rules": []any{}, "loadbalancer": "DIGITALOCEAN"},
+	}, mergePatch(target, patch))
+}
+
+func TestDiffSpecs(t *testing.T) {
+	proposed := testAppSpec()
+	proposed.Services[0].InstanceCount = 2
+	proposed.Services[0].Envs = proposed.Services[0].Envs[:1]
+	proposed.Services = append(proposed.Services[:1], &godo.AppServiceSpec{Name: "worker"})
+
+	changes, err := diffSpecs(testAppSpec(), proposed)
+	require.NoError(t, err)
+	require.Equal(t, []specChange{
+		{Path: "services[web].envs[API_KEY]", Op: "removed", Before: map[string]any{"key": "API_KEY", "value": redactedValue, "type": "SECRET"}},
+		{Path: "services[web].instance_count", Op: "changed", Before: float64(1), After: float64(2)},
+		{Path: "services[api]", Op: "removed", Before: map[string]any{"name": "api", "instance_size_slug": "apps-s-1vcpu-1gb", "instance_count": float64(1)}},
+		{Path: "services[worker]", Op: "added", After: map[string]any{"name": "worker"}},
+	}, changes)
+}
+
+func TestProposeSpec(t *testing.T) {
+	scaledSpec := testAppSpec()
+	scaledSpec.Services[1].InstanceCount = 3
+	patch := func() map[string]any {
+		services, _ := toJSONValue(scaledSpec.Services)
+		return map[string]any{"services": services}
+	}
+
+	tests := []struct {
+		name         string
+		args         map[string]any
+		mock         func(app *MockAppsService)
+		expectError  bool
+		expectedText string
+	}{
+		{
+			name: "Valid proposal",
+			args: map[string]any{"AppID": "app-123", "Patch": patch()},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+				app.EXPECT().Propose(gomock.Any(), &godo.AppProposeRequest{Spec: testAppSpec(), AppID: "app-123"}).
+					Return(&godo.AppProposeResponse{AppCost: 17}, nil, nil).Times(1)
+				app.EXPECT().Propose(gomock.Any(), &godo.AppProposeRequest{Spec: scaledSpec, AppID: "app-123"}).
+					Return(&godo.AppProposeResponse{AppCost: 41}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Unchanged spec",
+			args: map[string]any{"AppID": "app-123", "Patch": map[string]any{"region": "nyc"}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+			},
+			expectedText: "The patch does not change the app spec",
+		},
+		{
+			name: "Unknown field",
+			args: map[string]any{"AppID": "app-123", "Patch": map[string]any{"servces": []any{}}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "Validation error",
+			args: map[string]any{"AppID": "app-123", "Patch": map[string]any{"region": "mars"}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+				app.EXPECT().Propose(gomock.Any(), gomock.Any()).Return(&godo.AppProposeResponse{AppCost: 17}, nil, nil).Times(1)
+				app.EXPECT().Propose(gomock.Any(), gomock.Any()).Return(nil, nil, fmt.Errorf("invalid region")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing patch",
+			args:        map[string]any{"AppID": "app-123"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			resp, err := tool.proposeSpec(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			text := resp.Content[0].(mcp.TextContent).Text
+			if tc.expectedText != "" {
+				require.Equal(t, tc.expectedText, text)
+				return
+			}
+			var result specProposalResult
+			require.NoError(t, json.Unmarshal([]byte(text), &result))
+			require.Equal(t, []specChange{{Path: "services[api].instance_count", Op: "changed", Before: float64(1), After: float64(3)}}, result.Changes)
+			require.Equal(t, specCost{Current: 17, Proposed: 41, Change: 24}, result.Cost)
+			_, ok := tool.proposals.get(result.ProposalID)
+			require.True(t, ok)
+		})
+	}
+}
+
+func TestProposeSpecRedactsSecrets(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	proposedSpec := testAppSpec()
+	proposedSpec.Services[0].Envs[1].Value = "rotated-key"
+	proposedSpec.Services[0].Envs = append(proposedSpec.Services[0].Envs, &godo.AppVariableDefinition{
+		Key: "DB_PASSWORD", Value: "hunter2", Type: godo.AppVariableType_Secret,
+	})
+	envs, err := toJSONValue(proposedSpec.Services[0].Envs)
+	require.NoError(t, err)
+	patch := map[string]any{"services": []any{
+		map[string]any{"name": "web", "instance_size_slug": "apps-s-1vcpu-0.5gb", "instance_count": float64(1), "envs": envs},
+		map[string]any{"name": "api", "instance_size_slug": "apps-s-1vcpu-1gb", "instance_count": float64(1)},
+	}}
+
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+	appService.EXPECT().Propose(gomock.Any(), &godo.AppProposeRequest{Spec: testAppSpec(), AppID: "app-123"}).
+		Return(&godo.AppProposeResponse{AppCost: 17}, nil, nil).Times(1)
+	appService.EXPECT().Propose(gomock.Any(), &godo.AppProposeRequest{Spec: proposedSpec, AppID: "app-123"}).
+		Return(&godo.AppProposeResponse{AppCost: 17}, nil, nil).Times(1)
+
+	resp, err := tool.proposeSpec(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Patch": patch}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	text := resp.Content[0].(mcp.TextContent).Text
+	for _, secret := range []string{"hunter2", "rotated-key", "EV[1:abc]"} {
+		require.NotContains(t, text, secret)
+	}
+
+	var result specProposalResult
+	require.NoError(t, json.Unmarshal([]byte(text), &result))
+	require.Equal(t, []specChange{
+		{Path: "services[web].envs[API_KEY].value", Op: "changed", Before: redactedValue, After: redactedValue},
+		{Path: "services[web].envs[DB_PASSWORD]", Op: "added", After: map[string]any{"key": "DB_PASSWORD", "value": redactedValue, "type": "SECRET"}},
+	}, result.Changes)
+	require.Equal(t, redactedValue, result.Spec.Services[0].Envs[2].Value)
+	require.Equal(t, "info", result.Spec.Services[0].Envs[0].Value)
+
+	// The stored proposal keeps the values so that applying it sets them.
+	stored, ok := tool.proposals.get(result.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, proposedSpec, stored.Spec)
+}
+
+func TestUpdateAppWithProposal(t *testing.T) {
+	proposedSpec := testAppSpec()
+	proposedSpec.Region = "ams"
+	baseHash, err := specHash(testAppSpec())
+	require.NoError(t, err)
+	changedSpec := testAppSpec()
+	changedSpec.Services[0].InstanceCount = 4
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mock        func(app *MockAppsService)
+		expectError bool
+	}{
+		{
+			name: "Apply proposal",
+			args: map[string]any{"update": map[string]any{"app_id": "app-123", "proposal_id": "proposal-1"}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(1)
+				app.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: proposedSpec}).
+					Return(&godo.App{ID: "app-123", Spec: proposedSpec}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Spec changed since the proposal",
+			args: map[string]any{"update": map[string]any{"proposal_id": "proposal-1"}},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: changedSpec}, nil, nil).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Proposal for another app",
+			args:        map[string]any{"update": map[string]any{"app_id": "app-456", "proposal_id": "proposal-1"}},
+			expectError: true,
+		},
+		{
+			name:        "Unknown proposal",
+			args:        map[string]any{"update": map[string]any{"app_id": "app-123", "proposal_id": "proposal-2"}},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client}
+			tool.proposals.put(&specProposal{ID: "proposal-1", AppID: "app-123", Spec: proposedSpec, BaseHash: baseHash, ExpiresAt: time.Now().Add(proposalTTL)})
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			resp, err := tool.updateApp(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tc.expectError {
+				require.True(t, resp.IsError)
+				return
+			}
+			require.False(t, resp.IsError)
+			// An applied proposal cannot be applied twice.
+			_, ok := tool.proposals.get("proposal-1")
+			require.False(t, ok)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package apps
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"gopkg.in/yaml.v3"
+)
+
+// maxSpecValidationErrors bounds the validation errors reported for a single document.
+const maxSpecValidationErrors = 20
+
+// specToYAML renders an app spec the way doctl does: the JSON field names, empty fields omitted and keys sorted.
+func specToYAML(spec *godo.AppSpec) ([]byte, error) {
+	value, err := toJSONValue(spec)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// specFromYAML parses a YAML app spec, either bare as in .do/app.yaml or wrapped in a spec key as in
+// .do/deploy.template.yaml, validating it against the app spec schema first.
+func specFromYAML(data []byte, schema map[string]any) (*godo.AppSpec, error) {
+	var doc any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid app spec: expected a mapping at the top level")
+	}
+	if wrapped, ok := obj["spec"].(map[string]any); ok && len(obj) == 1 {
+		obj = wrapped
+	}
+
+	var errs []string
+	validateSpecValue("spec", obj, schema, &errs)
+	if len(errs) > 0 {
+		if len(errs) > maxSpecValidationErrors {
+			errs = append(errs[:maxSpecValidationErrors], fmt.Sprintf("and %d more", len(errs)-maxSpecValidationErrors))
+		}
+		return nil, fmt.Errorf("invalid app spec:\n%s", strings.Join(errs, "\n"))
+	}
+	return decodeSpec(obj)
+}
+
+// validateSpecValue checks a decoded YAML value against a node of the generated app spec schema, collecting an
+// error per unknown field or mismatched type.
+func validateSpecValue(path string, value any, schema map[string]any, errs *[]string) {
+	if value == nil {
+		return
+	}
+	schemaType, _ := schema["type"].(string)
+	switch schemaType {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected an object, got %s", path, yamlTypeName(value)))
+			return
+		}
+		properties, ok := schema["properties"].(map[string]any)
+		if !ok {
+			return
+		}
+		keys := make([]string, 0, len(obj))
+		for k := range obj {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			property, ok := properties[k].(map[string]any)
+			if !ok {
+				*errs = append(*errs, fmt.Sprintf("%s.%s: unknown field", path, k))
+				continue
+			}
+			validateSpecValue(path+"."+k, obj[k], property, errs)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected a list, got %s", path, yamlTypeName(value)))
+			return
+		}
+		itemSchema, ok := schema["items"].(map[string]any)
+		if !ok {
+			return
+		}
+		for i, item := range items {
+			validateSpecValue(fmt.Sprintf("%s[%d]", path, i), item, itemSchema, errs)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected a string, got %s", path, yamlTypeName(value)))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			*errs = append(*errs, fmt.Sprintf("%s: expected a boolean, got %s", path, yamlTypeName(value)))
+		}
+	case "integer":
+		switch v := value.(type) {
+		case int, int64, uint64:
+		case float64:
+			if v != math.Trunc(v) {
+				*errs = append(*errs, fmt.Sprintf("%s: expected an integer, got %v", path, v))
+			}
+		default:
+			*errs = append(*errs, fmt.Sprintf("%s: expected an integer, got %s", path, yamlTypeName(value)))
+		}
+	case "number":
+		switch value.(type) {
+		case int, int64, uint64, float64:
+		default:
+			*errs = append(*errs, fmt.Sprintf("%s: expected a number, got %s", path, yamlTypeName(value)))
+		}
+	}
+}
+
+// yamlTypeName names the type of a decoded YAML value for validation errors.
+func yamlTypeName(value any) string {
+	switch value.(type) {
+	case map[string]any:
+		return "an object"
+	case []any:
+		return "a list"
+	case string:
+		return "a string"
+	case bool:
+		return "a boolean"
+	case int, int64, uint64, float64:
+		return "a number"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// parseAppSpecSchema extracts the schema of the app spec from the generated app create schema.
+func parseAppSpecSchema(raw []byte) (map[string]any, error) {
+	var schema struct {
+		Properties struct {
+			Spec map[string]any `json:"spec"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, fmt.Errorf("failed to parse app create schema: %w", err)
+	}
+	if schema.Properties.Spec == nil {
+		return nil, fmt.Errorf("app create schema has no spec")
+	}
+	return schema.Properties.Spec, nil
+}
+
+// appSpecSchema returns the schema of the app spec. It is set by Tools when the app create schema is loaded, and
+// loaded from that schema here otherwise.
+func (a *AppPlatformTool) appSpecSchema() (map[string]any, error) {
+	if a.specSchema != nil {
+		return a.specSchema, nil
+	}
+	raw, err := loadSchema("app-create-schema.json")
+	if err != nil {
+		return nil, err
+	}
+	return parseAppSpecSchema(raw)
+}
+
+// exportSpecYAML exports the spec of an app as YAML, optionally writing it to a file such as .do/app.yaml.
+func (a *AppPlatformTool) exportSpecYAML(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	appID, ok := args["AppID"].(string)
+	if !ok || appID == "" {
+		return mcp.NewToolResultError("App ID is required"), nil
+	}
+	path, _ := args["Path"].(string)
+
+	app, _, err := a.client.Apps.Get(ctx, appID)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	data, err := specToYAML(app.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	if path == "" {
+		return mcp.NewToolResultText(string(data)), nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to create directory", err), nil
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to write spec", err), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Spec of app %s written to %s\n\n%s", appID, path, data)), nil
+}
+
+// importSpecYAML creates an app from a YAML spec, or updates an existing app with it.
+func (a *AppPlatformTool) importSpecYAML(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	content, _ := args["YAML"].(string)
+	path, _ := args["Path"].(string)
+	if (content == "") == (path == "") {
+		return mcp.NewToolResultError("Exactly one of YAML or Path is required"), nil
+	}
+	appID, _ := args["AppID"].(string)
+	projectID, _ := args["ProjectID"].(string)
+	dryRun, _ := args["DryRun"].(bool)
+
+	data := []byte(content)
+	if path != "" {
+		var err error
+		if data, err = os.ReadFile(path); err != nil {
+			return mcp.NewToolResultErrorFromErr("failed to read spec", err), nil
+		}
+	}
+	schema, err := a.appSpecSchema()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load app spec schema: %w", err)
+	}
+	spec, err := specFromYAML(data, schema)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	if dryRun {
+		proposal, _, err := a.client.Apps.Propose(ctx, &godo.AppProposeRequest{Spec: spec, AppID: appID})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("spec validation failed", err), nil
+		}
+		proposalJSON, err := json.MarshalIndent(proposal, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+		return mcp.NewToolResultText(string(proposalJSON)), nil
+	}
+
+	var app *godo.App
+	if appID != "" {
+		app, _, err = a.client.Apps.Update(ctx, appID, &godo.AppUpdateRequest{Spec: spec})
+	} else {
+		app, _, err = a.client.Apps.Create(ctx, &godo.AppCreateRequest{Spec: spec, ProjectID: projectID})
+	}
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	appJSON, err := json.MarshalIndent(app, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(appJSON)), nil
+}
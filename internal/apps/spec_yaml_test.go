@@ -0,0 +1,178 @@
+package apps
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testAppYAML = `name: shop
+region: nyc
+services:
+  - name: web
+    instance_count: 2
+    instance_size_slug: apps-s-1vcpu-0.5gb
+    github:
+      repo: example/shop
+      branch: main
+      deploy_on_push: true
+    envs:
+      - key: LOG_LEVEL
+        value: info
+`
+
+func loadTestSpecSchema(t *testing.T) map[string]any {
+	raw, err := os.ReadFile(filepath.Join("spec", "app-create-schema.json"))
+	require.NoError(t, err)
+	schema, err := parseAppSpecSchema(raw)
+	require.NoError(t, err)
+	return schema
+}
+
+func TestSpecYAMLRoundTrip(t *testing.T) {
+	schema := loadTestSpecSchema(t)
+
+	data, err := specToYAML(testAppSpec())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(data), "name: shop\nregion: nyc\nservices:\n"), string(data))
+
+	spec, err := specFromYAML(data, schema)
+	require.NoError(t, err)
+	require.Equal(t, testAppSpec(), spec)
+
+	// The deploy template format wraps the spec in a spec key.
+	spec, err = specFromYAML([]byte("spec:\n  name: shop\n"), schema)
+	require.NoError(t, err)
+	require.Equal(t, &godo.AppSpec{Name: "shop"}, spec)
+}
+
+func TestSpecFromYAMLValidation(t *testing.T) {
+	schema := loadTestSpecSchema(t)
+
+	_, err := specFromYAML([]byte("name: shop\nservces: []\nservices:\n  - name: web\n    instance_count: two\n    envs:\n      - key: PORT\n        value: 8080\n"), schema)
+	require.Error(t, err)
+	require.Equal(t, strings.Join([]string{
+		"invalid app spec:",
+		"spec.servces: unknown field",
+		"spec.services[0].envs[0].value: expected a string, got a number",
+		"spec.services[0].instance_count: expected an integer, got a string",
+	}, "\n"), err.Error())
+
+	_, err = specFromYAML([]byte("- name: shop\n"), schema)
+	require.Error(t, err)
+	_, err = specFromYAML([]byte("name: [shop"), schema)
+	require.Error(t, err)
+}
+
+func TestImportSpecYAML(t *testing.T) {
+	specFile := filepath.Join(t.TempDir(), "app.yaml")
+	require.NoError(t, os.WriteFile(specFile, []byte(testAppYAML), 0o644))
+	expectedSpec := &godo.AppSpec{
+		Name:   "shop",
+		Region: "nyc",
+		Services: []*godo.AppServiceSpec{{
+			Name:             "web",
+			InstanceCount:    2,
+			InstanceSizeSlug: "apps-s-1vcpu-0.5gb",
+			GitHub:           &godo.GitHubSourceSpec{Repo: "example/shop", Branch: "main", DeployOnPush: true},
+			Envs:             []*godo.AppVariableDefinition{{Key: "LOG_LEVEL", Value: "info"}},
+		}},
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mock        func(app *MockAppsService)
+		expectError bool
+	}{
+		{
+			name: "Create from YAML",
+			args: map[string]any{"YAML": testAppYAML, "ProjectID": "project-1"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Create(gomock.Any(), &godo.AppCreateRequest{Spec: expectedSpec, ProjectID: "project-1"}).
+					Return(&godo.App{ID: "app-123", Spec: expectedSpec}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Update from file",
+			args: map[string]any{"Path": specFile, "AppID": "app-123"},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Update(gomock.Any(), "app-123", &godo.AppUpdateRequest{Spec: expectedSpec}).
+					Return(&godo.App{ID: "app-123", Spec: expectedSpec}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "Dry run",
+			args: map[string]any{"Path": specFile, "DryRun": true},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Propose(gomock.Any(), &godo.AppProposeRequest{Spec: expectedSpec}).
+					Return(&godo.AppProposeResponse{AppCost: 10}, nil, nil).Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"YAML": testAppYAML},
+			mock: func(app *MockAppsService) {
+				app.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, nil, fmt.Errorf("api error")).Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Invalid spec",
+			args:        map[string]any{"YAML": "name: shop\nregions: nyc\n"},
+			expectError: true,
+		},
+		{
+			name:        "Both YAML and Path",
+			args:        map[string]any{"YAML": testAppYAML, "Path": specFile},
+			expectError: true,
+		},
+		{
+			name:        "Missing file",
+			args:        map[string]any{"Path": filepath.Join(t.TempDir(), "missing.yaml")},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client, appService := setupMock(t)
+			tool := &AppPlatformTool{client: client, specSchema: loadTestSpecSchema(t)}
+			if tc.mock != nil {
+				tc.mock(appService)
+			}
+			resp, err := tool.importSpecYAML(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
+
+func TestExportSpecYAML(t *testing.T) {
+	client, appService := setupMock(t)
+	tool := &AppPlatformTool{client: client}
+	appService.EXPECT().Get(gomock.Any(), "app-123").Return(&godo.App{ID: "app-123", Spec: testAppSpec()}, nil, nil).Times(2)
+	path := filepath.Join(t.TempDir(), ".do", "app.yaml")
+
+	resp, err := tool.exportSpecYAML(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	expected, err := specToYAML(testAppSpec())
+	require.NoError(t, err)
+	require.Equal(t, string(expected), resp.Content[0].(mcp.TextContent).Text)
+
+	resp, err = tool.exportSpecYAML(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"AppID": "app-123", "Path": path}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, expected, written)
+}
@@ -0,0 +1,8 @@
+package main
+
+import "net/http"
+
+func main() {
+	http.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {})
+	_ = http.ListenAndServe(":3000", nil)
+}
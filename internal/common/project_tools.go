@@ -0,0 +1,329 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultProjectsPageSize = 50
+	defaultProjectsPage     = 1
+
+	defaultProjectID = "default"
+)
+
+// ProjectTools provides tool-based handlers for DigitalOcean projects.
+type ProjectTools struct {
+	client *godo.Client
+}
+
+// NewProjectTools creates a new ProjectTools instance.
+func NewProjectTools(client *godo.Client) *ProjectTools {
+	return &ProjectTools{client: client}
+}
+
+// projectResource is a resource assigned to a project, with its type and ID parsed from the URN.
+type projectResource struct {
+	URN        string `json:"urn"`
+	Type       string `json:"type"`
+	ID         string `json:"id"`
+	AssignedAt string `json:"assigned_at,omitempty"`
+	Status     string `json:"status,omitempty"`
+}
+
+// newProjectResource parses a resource URN of the form do:<type>:<id>.
+func newProjectResource(resource godo.ProjectResource) projectResource {
+	pr := projectResource{URN: resource.URN, AssignedAt: resource.AssignedAt, Status: resource.Status}
+	parts := strings.SplitN(resource.URN, ":", 3)
+	if len(parts) == 3 {
+		pr.Type = parts[1]
+		pr.ID = parts[2]
+	}
+	return pr
+}
+
+// listProjects lists projects with pagination support.
+func (p *ProjectTools) listProjects(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	page, ok := req.GetArguments()["Page"].(float64)
+	if !ok {
+		page = defaultProjectsPage
+	}
+	perPage, ok := req.GetArguments()["PerPage"].(float64)
+	if !ok {
+		perPage = defaultProjectsPageSize
+	}
+
+	projects, _, err := p.client.Projects.List(ctx, &godo.ListOptions{Page: int(page), PerPage: int(perPage)})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(projects, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// getProject retrieves a project by ID, or the default project when the ID is omitted or "default".
+func (p *ProjectTools) getProject(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, _ := req.GetArguments()["ID"].(string)
+
+	var (
+		project *godo.Project
+		err     error
+	)
+	if id == "" || id == defaultProjectID {
+		project, _, err = p.client.Projects.GetDefault(ctx)
+	} else {
+		project, _, err = p.client.Projects.Get(ctx, id)
+	}
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(project, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// createProject creates a new project.
+func (p *ProjectTools) createProject(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Project name is required"), nil
+	}
+	purpose, ok := args["Purpose"].(string)
+	if !ok || purpose == "" {
+		return mcp.NewToolResultError("Project purpose is required"), nil
+	}
+	description, _ := args["Description"].(string)
+	environment, _ := args["Environment"].(string)
+
+	project, _, err := p.client.Projects.Create(ctx, &godo.CreateProjectRequest{
+		Name:        name,
+		Description: description,
+		Purpose:     purpose,
+		Environment: environment,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(project, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// updateProject updates the provided attributes of a project, leaving the others unchanged.
+func (p *ProjectTools) updateProject(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Project ID is required"), nil
+	}
+
+	updateRequest := &godo.UpdateProjectRequest{}
+	if v, ok := args["Name"].(string); ok && v != "" {
+		updateRequest.Name = v
+	}
+	if v, ok := args["Description"].(string); ok {
+		updateRequest.Description = v
+	}
+	if v, ok := args["Purpose"].(string); ok && v != "" {
+		updateRequest.Purpose = v
+	}
+	if v, ok := args["Environment"].(string); ok && v != "" {
+		updateRequest.Environment = v
+	}
+	if v, ok := args["IsDefault"].(bool); ok {
+		updateRequest.IsDefault = v
+	}
+
+	project, _, err := p.client.Projects.Update(ctx, id, updateRequest)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(project, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// deleteProject deletes a project. The project must not contain any resources.
+func (p *ProjectTools) deleteProject(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Project ID is required"), nil
+	}
+
+	_, err := p.client.Projects.Delete(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	return mcp.NewToolResultText("Project deleted successfully"), nil
+}
+
+// listProjectResources lists the resources assigned to a project, with their type and ID parsed from the URN.
+func (p *ProjectTools) listProjectResources(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Project ID is required"), nil
+	}
+	page, ok := args["Page"].(float64)
+	if !ok {
+		page = defaultProjectsPage
+	}
+	perPage, ok := args["PerPage"].(float64)
+	if !ok {
+		perPage = defaultProjectsPageSize
+	}
+
+	resources, _, err := p.client.Projects.ListResources(ctx, id, &godo.ListOptions{Page: int(page), PerPage: int(perPage)})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	out := make([]projectResource, len(resources))
+	for i, resource := range resources {
+		out[i] = newProjectResource(resource)
+	}
+
+	jsonData, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// assignProjectResources moves resources, identified by URN, into a project.
+func (p *ProjectTools) assignProjectResources(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Project ID is required"), nil
+	}
+
+	var urns []any
+	if rawURNs, ok := args["URNs"].([]any); ok {
+		for _, v := range rawURNs {
+			if urn, ok := v.(string); ok && urn != "" {
+				urns = append(urns, urn)
+			}
+		}
+	}
+	if len(urns) == 0 {
+		return mcp.NewToolResultError("At least one resource URN is required"), nil
+	}
+
+	resources, _, err := p.client.Projects.AssignResources(ctx, id, urns...)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	out := make([]projectResource, len(resources))
+	for i, resource := range resources {
+		out[i] = newProjectResource(resource)
+	}
+
+	jsonData, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// Tools returns the list of server tools for projects.
+func (p *ProjectTools) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: p.listProjects,
+			Tool: mcp.NewTool(
+				"project-list",
+				mcp.WithDescription("List all projects. Supports pagination."),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultProjectsPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultProjectsPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: p.getProject,
+			Tool: mcp.NewTool(
+				"project-get",
+				mcp.WithDescription("Get a project by ID. Omit the ID or use 'default' to get the default project."),
+				mcp.WithString("ID", mcp.Description("Project ID, or 'default'")),
+			),
+		},
+		{
+			Handler: p.createProject,
+			Tool: mcp.NewTool(
+				"project-create",
+				mcp.WithDescription("Create a new project"),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the project")),
+				mcp.WithString("Purpose", mcp.Required(), mcp.Description("Purpose of the project (e.g., 'Website or blog', 'Service or API')")),
+				mcp.WithString("Description", mcp.Description("Description of the project")),
+				mcp.WithString("Environment", mcp.Description("Environment of the project: 'Development', 'Staging' or 'Production'")),
+			),
+		},
+		{
+			Handler: p.updateProject,
+			Tool: mcp.NewTool(
+				"project-update",
+				mcp.WithDescription("Update a project. Only the provided attributes are changed."),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("Project ID")),
+				mcp.WithString("Name", mcp.Description("New name of the project")),
+				mcp.WithString("Description", mcp.Description("New description of the project")),
+				mcp.WithString("Purpose", mcp.Description("New purpose of the project")),
+				mcp.WithString("Environment", mcp.Description("New environment: 'Development', 'Staging' or 'Production'")),
+				mcp.WithBoolean("IsDefault", mcp.Description("Whether the project becomes the default project")),
+			),
+		},
+		{
+			Handler: p.deleteProject,
+			Tool: mcp.NewTool(
+				"project-delete",
+				mcp.WithDescription("Delete a project. The project must not contain any resources."),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("Project ID")),
+			),
+		},
+		{
+			Handler: p.listProjectResources,
+			Tool: mcp.NewTool(
+				"project-list-resources",
+				mcp.WithDescription("List the resources assigned to a project, with their type and ID. Supports pagination."),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("Project ID")),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultProjectsPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultProjectsPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: p.assignProjectResources,
+			Tool: mcp.NewTool(
+				"project-assign-resources",
+				mcp.WithDescription("Assign resources to a project by URN. Resources are moved out of their current project."),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("Project ID")),
+				mcp.WithArray("URNs", mcp.Required(), mcp.Description("Resource URNs of the form do:<type>:<id> (e.g., do:droplet:12345, do:kubernetes:<uuid>, do:dbaas:<uuid>, do:app:<uuid>)"), mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+	}
+}
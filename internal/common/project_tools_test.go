@@ -0,0 +1,160 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupProjectToolsWithMock(mockProjects *MockProjectsService) *ProjectTools {
+	client := &godo.Client{}
+	client.Projects = mockProjects
+	return NewProjectTools(client)
+}
+
+func TestProjectTools_getProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockProjectsService)
+		expectError bool
+		expectedID  string
+	}{
+		{
+			name: "Get by ID",
+			args: map[string]any{"ID": "project-1"},
+			mockSetup: func(m *MockProjectsService) {
+				m.EXPECT().
+					Get(gomock.Any(), "project-1").
+					Return(&godo.Project{ID: "project-1", Name: "web"}, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectedID: "project-1",
+		},
+		{
+			name: "Default project",
+			args: map[string]any{"ID": "default"},
+			mockSetup: func(m *MockProjectsService) {
+				m.EXPECT().
+					GetDefault(gomock.Any()).
+					Return(&godo.Project{ID: "project-default", IsDefault: true}, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectedID: "project-default",
+		},
+		{
+			name: "API error",
+			args: map[string]any{"ID": "missing"},
+			mockSetup: func(m *MockProjectsService) {
+				m.EXPECT().
+					Get(gomock.Any(), "missing").
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockProjects := NewMockProjectsService(ctrl)
+			tc.mockSetup(mockProjects)
+			tool := setupProjectToolsWithMock(mockProjects)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.getProject(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			var project godo.Project
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &project))
+			require.Equal(t, tc.expectedID, project.ID)
+		})
+	}
+}
+
+func TestProjectTools_createAndUpdateProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProjects := NewMockProjectsService(ctrl)
+	mockProjects.EXPECT().
+		Create(gomock.Any(), &godo.CreateProjectRequest{Name: "billing", Purpose: "Service or API", Environment: "Production"}).
+		Return(&godo.Project{ID: "project-2", Name: "billing"}, &godo.Response{}, nil).
+		Times(1)
+	mockProjects.EXPECT().
+		Update(gomock.Any(), "project-2", &godo.UpdateProjectRequest{Name: "billing-api", IsDefault: true}).
+		Return(&godo.Project{ID: "project-2", Name: "billing-api", IsDefault: true}, &godo.Response{}, nil).
+		Times(1)
+	tool := setupProjectToolsWithMock(mockProjects)
+
+	resp, err := tool.createProject(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Name":        "billing",
+		"Purpose":     "Service or API",
+		"Environment": "Production",
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.updateProject(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID":        "project-2",
+		"Name":      "billing-api",
+		"IsDefault": true,
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "billing-api")
+
+	resp, err = tool.createProject(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Name": "billing"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestProjectTools_resources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProjects := NewMockProjectsService(ctrl)
+	mockProjects.EXPECT().
+		ListResources(gomock.Any(), "project-1", &godo.ListOptions{Page: 1, PerPage: 50}).
+		Return([]godo.ProjectResource{
+			{URN: "do:droplet:123", AssignedAt: "2025-01-01T00:00:00Z", Status: "ok"},
+			{URN: "do:kubernetes:bd5f5959-5e1e-4205-a714-a914373942af"},
+		}, &godo.Response{}, nil).
+		Times(1)
+	mockProjects.EXPECT().
+		AssignResources(gomock.Any(), "project-1", "do:dbaas:db-uuid", "do:app:app-uuid").
+		Return([]godo.ProjectResource{{URN: "do:dbaas:db-uuid"}, {URN: "do:app:app-uuid"}}, &godo.Response{}, nil).
+		Times(1)
+	tool := setupProjectToolsWithMock(mockProjects)
+
+	resp, err := tool.listProjectResources(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": "project-1"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var resources []projectResource
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &resources))
+	require.Equal(t, projectResource{URN: "do:droplet:123", Type: "droplet", ID: "123", AssignedAt: "2025-01-01T00:00:00Z", Status: "ok"}, resources[0])
+	require.Equal(t, "kubernetes", resources[1].Type)
+
+	resp, err = tool.assignProjectResources(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID":   "project-1",
+		"URNs": []any{"do:dbaas:db-uuid", "do:app:app-uuid"},
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.assignProjectResources(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": "project-1"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -0,0 +1,362 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultTagsPageSize = 50
+	defaultTagsPage     = 1
+
+	// kubernetesResourceType is not a tag API resource type, DOKS clusters are tagged through the Kubernetes API instead.
+	kubernetesResourceType = "kubernetes"
+)
+
+// taggableResourceTypes lists the resource types accepted by tag-resources and untag-resources.
+var taggableResourceTypes = []string{
+	string(godo.DropletResourceType),
+	string(godo.ImageResourceType),
+	string(godo.VolumeResourceType),
+	string(godo.VolumeSnapshotResourceType),
+	string(godo.DatabaseResourceType),
+	string(godo.LoadBalancerResourceType),
+	kubernetesResourceType,
+}
+
+// TagTools provides tool-based handlers for DigitalOcean tags.
+type TagTools struct {
+	client *godo.Client
+}
+
+// NewTagTools creates a new TagTools instance.
+func NewTagTools(client *godo.Client) *TagTools {
+	return &TagTools{client: client}
+}
+
+// tagSummary is a tag along with the number of resources of each type it is applied to.
+type tagSummary struct {
+	Name            string `json:"name"`
+	Total           int    `json:"total"`
+	Droplets        int    `json:"droplets"`
+	Images          int    `json:"images"`
+	Volumes         int    `json:"volumes"`
+	VolumeSnapshots int    `json:"volume_snapshots"`
+	Databases       int    `json:"databases"`
+}
+
+// newTagSummary counts the resources of a tag per resource type.
+func newTagSummary(tag godo.Tag) tagSummary {
+	summary := tagSummary{Name: tag.Name}
+	if tag.Resources == nil {
+		return summary
+	}
+	summary.Total = tag.Resources.Count
+	if tag.Resources.Droplets != nil {
+		summary.Droplets = tag.Resources.Droplets.Count
+	}
+	if tag.Resources.Images != nil {
+		summary.Images = tag.Resources.Images.Count
+	}
+	if tag.Resources.Volumes != nil {
+		summary.Volumes = tag.Resources.Volumes.Count
+	}
+	if tag.Resources.VolumeSnapshots != nil {
+		summary.VolumeSnapshots = tag.Resources.VolumeSnapshots.Count
+	}
+	if tag.Resources.Databases != nil {
+		summary.Databases = tag.Resources.Databases.Count
+	}
+	return summary
+}
+
+// listTags lists tags along with per-type resource counts.
+func (t *TagTools) listTags(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	page, ok := req.GetArguments()["Page"].(float64)
+	if !ok {
+		page = defaultTagsPage
+	}
+	perPage, ok := req.GetArguments()["PerPage"].(float64)
+	if !ok {
+		perPage = defaultTagsPageSize
+	}
+
+	tags, _, err := t.client.Tags.List(ctx, &godo.ListOptions{Page: int(page), PerPage: int(perPage)})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	summaries := make([]tagSummary, len(tags))
+	for i, tag := range tags {
+		summaries[i] = newTagSummary(tag)
+	}
+
+	jsonData, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// getTag retrieves a tag, including the last tagged resource of each type.
+func (t *TagTools) getTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := req.GetArguments()["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Tag name is required"), nil
+	}
+
+	tag, _, err := t.client.Tags.Get(ctx, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(tag, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// createTag creates a new tag.
+func (t *TagTools) createTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := req.GetArguments()["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Tag name is required"), nil
+	}
+
+	tag, _, err := t.client.Tags.Create(ctx, &godo.TagCreateRequest{Name: name})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(tag, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// deleteTag deletes a tag. The tag is removed from all resources it was applied to.
+func (t *TagTools) deleteTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := req.GetArguments()["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Tag name is required"), nil
+	}
+
+	_, err := t.client.Tags.Delete(ctx, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	return mcp.NewToolResultText("Tag deleted successfully"), nil
+}
+
+// parseTagResources splits the Resources argument into tag API resources and DOKS cluster IDs.
+func parseTagResources(args map[string]any) ([]godo.Resource, []string, error) {
+	rawResources, ok := args["Resources"].([]any)
+	if !ok || len(rawResources) == 0 {
+		return nil, nil, fmt.Errorf("at least one resource is required")
+	}
+
+	var resources []godo.Resource
+	var clusterIDs []string
+	for _, raw := range rawResources {
+		resource, ok := raw.(map[string]any)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid resource: %v", raw)
+		}
+		id, _ := resource["ID"].(string)
+		if id == "" {
+			if numericID, ok := resource["ID"].(float64); ok {
+				id = strconv.Itoa(int(numericID))
+			}
+		}
+		resourceType, _ := resource["Type"].(string)
+		if id == "" || resourceType == "" {
+			return nil, nil, fmt.Errorf("each resource requires an ID and a Type")
+		}
+		if !slices.Contains(taggableResourceTypes, resourceType) {
+			return nil, nil, fmt.Errorf("unsupported resource type: %s", resourceType)
+		}
+
+		if resourceType == kubernetesResourceType {
+			clusterIDs = append(clusterIDs, id)
+			continue
+		}
+		resources = append(resources, godo.Resource{ID: id, Type: godo.ResourceType(resourceType)})
+	}
+
+	return resources, clusterIDs, nil
+}
+
+// tagResources applies a tag to a set of resources.
+func (t *TagTools) tagResources(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Tag name is required"), nil
+	}
+
+	resources, clusterIDs, err := parseTagResources(args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("invalid resources", err), nil
+	}
+
+	if len(resources) > 0 {
+		_, err = t.client.Tags.TagResources(ctx, name, &godo.TagResourcesRequest{Resources: resources})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
+	for _, clusterID := range clusterIDs {
+		err := t.updateClusterTags(ctx, clusterID, func(tags []string) []string {
+			if slices.Contains(tags, name) {
+				return tags
+			}
+			return append(tags, name)
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("api error tagging cluster %s", clusterID), err), nil
+		}
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Tagged %d resources with %s", len(resources)+len(clusterIDs), name)), nil
+}
+
+// untagResources removes a tag from a set of resources.
+func (t *TagTools) untagResources(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Tag name is required"), nil
+	}
+
+	resources, clusterIDs, err := parseTagResources(args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("invalid resources", err), nil
+	}
+
+	if len(resources) > 0 {
+		_, err = t.client.Tags.UntagResources(ctx, name, &godo.UntagResourcesRequest{Resources: resources})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+	}
+
+	for _, clusterID := range clusterIDs {
+		err := t.updateClusterTags(ctx, clusterID, func(tags []string) []string {
+			return slices.DeleteFunc(tags, func(tag string) bool { return tag == name })
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("api error untagging cluster %s", clusterID), err), nil
+		}
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Removed tag %s from %d resources", name, len(resources)+len(clusterIDs))), nil
+}
+
+// updateClusterTags rewrites the tags of a DOKS cluster, since clusters are not supported by the tag API.
+func (t *TagTools) updateClusterTags(ctx context.Context, clusterID string, mutate func([]string) []string) error {
+	cluster, _, err := t.client.Kubernetes.Get(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	// The update endpoint requires the cluster name, so it is sent back unchanged.
+	tags := mutate(slices.Clone(cluster.Tags))
+	if len(tags) > 0 {
+		_, _, err = t.client.Kubernetes.Update(ctx, clusterID, &godo.KubernetesClusterUpdateRequest{Name: cluster.Name, Tags: tags})
+		return err
+	}
+
+	// KubernetesClusterUpdateRequest omits an empty tag list, which would leave the last tag in place, so the
+	// empty list is sent explicitly.
+	body := struct {
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}{Name: cluster.Name, Tags: []string{}}
+	httpReq, err := t.client.NewRequest(ctx, http.MethodPut, "/v2/kubernetes/clusters/"+clusterID, body)
+	if err != nil {
+		return err
+	}
+	_, err = t.client.Do(ctx, httpReq, nil)
+	return err
+}
+
+// Tools returns the list of server tools for tags.
+func (t *TagTools) Tools() []server.ServerTool {
+	resourcesSchema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"ID":   map[string]any{"type": "string", "description": "ID of the resource (droplet ID, image ID, volume UUID, snapshot ID, database UUID, load balancer UUID or DOKS cluster UUID)"},
+			"Type": map[string]any{"type": "string", "enum": taggableResourceTypes, "description": "Type of the resource"},
+		},
+		"required": []string{"ID", "Type"},
+	}
+
+	return []server.ServerTool{
+		{
+			Handler: t.listTags,
+			Tool: mcp.NewTool(
+				"tag-list",
+				mcp.WithDescription("List tags with the number of droplets, images, volumes, volume snapshots and databases each tag is applied to. Supports pagination."),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultTagsPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultTagsPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: t.getTag,
+			Tool: mcp.NewTool(
+				"tag-get",
+				mcp.WithDescription("Get a tag by name, including resource counts and the last tagged resource of each type."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the tag")),
+			),
+		},
+		{
+			Handler: t.createTag,
+			Tool: mcp.NewTool(
+				"tag-create",
+				mcp.WithDescription("Create a new tag. Tag names may contain letters, numbers, colons, dashes and underscores."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the tag")),
+			),
+		},
+		{
+			Handler: t.deleteTag,
+			Tool: mcp.NewTool(
+				"tag-delete",
+				mcp.WithDescription("Delete a tag. The tag is removed from every resource it was applied to."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the tag")),
+			),
+		},
+		{
+			Handler: t.tagResources,
+			Tool: mcp.NewTool(
+				"tag-resources",
+				mcp.WithDescription("Apply an existing tag to droplets, images, volumes, volume snapshots, databases, load balancers or DOKS clusters. Create the tag first with tag-create."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the tag")),
+				mcp.WithArray("Resources", mcp.Required(), mcp.Description("Resources to tag"), mcp.Items(resourcesSchema)),
+			),
+		},
+		{
+			Handler: t.untagResources,
+			Tool: mcp.NewTool(
+				"tag-untag-resources",
+				mcp.WithDescription("Remove a tag from droplets, images, volumes, volume snapshots, databases, load balancers or DOKS clusters."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the tag")),
+				mcp.WithArray("Resources", mcp.Required(), mcp.Description("Resources to untag"), mcp.Items(resourcesSchema)),
+			),
+		},
+	}
+}
@@ -0,0 +1,274 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTagToolsWithMocks(mockTags *MockTagsService, mockKubernetes *MockKubernetesService) *TagTools {
+	client := &godo.Client{}
+	client.Tags = mockTags
+	client.Kubernetes = mockKubernetes
+	return NewTagTools(client)
+}
+
+func TestTagTools_listTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testTags := []godo.Tag{
+		{
+			Name: "prod",
+			Resources: &godo.TaggedResources{
+				Count:     5,
+				Droplets:  &godo.TaggedDropletsResources{Count: 3},
+				Volumes:   &godo.TaggedVolumesResources{Count: 1},
+				Databases: &godo.TaggedDatabasesResources{Count: 1},
+			},
+		},
+		{Name: "empty"},
+	}
+
+	tests := []struct {
+		name        string
+		mockSetup   func(*MockTagsService)
+		expectError bool
+	}{
+		{
+			name: "Successful list",
+			mockSetup: func(m *MockTagsService) {
+				m.EXPECT().
+					List(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: 50}).
+					Return(testTags, &godo.Response{}, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			mockSetup: func(m *MockTagsService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTags := NewMockTagsService(ctrl)
+			tc.mockSetup(mockTags)
+			tool := setupTagToolsWithMocks(mockTags, nil)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}}
+			resp, err := tool.listTags(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			var summaries []tagSummary
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &summaries))
+			require.Equal(t, []tagSummary{
+				{Name: "prod", Total: 5, Droplets: 3, Volumes: 1, Databases: 1},
+				{Name: "empty"},
+			}, summaries)
+		})
+	}
+}
+
+func TestTagTools_createAndDeleteTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTags := NewMockTagsService(ctrl)
+	mockTags.EXPECT().
+		Create(gomock.Any(), &godo.TagCreateRequest{Name: "prod"}).
+		Return(&godo.Tag{Name: "prod"}, &godo.Response{}, nil).
+		Times(1)
+	mockTags.EXPECT().
+		Delete(gomock.Any(), "prod").
+		Return(&godo.Response{}, nil).
+		Times(1)
+	tool := setupTagToolsWithMocks(mockTags, nil)
+
+	resp, err := tool.createTag(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Name": "prod"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.deleteTag(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Name": "prod"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.createTag(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestTagTools_tagResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockTagsService, *MockKubernetesService)
+		expectError bool
+		expectText  string
+	}{
+		{
+			name: "Droplets, volumes and a DOKS cluster",
+			args: map[string]any{
+				"Name": "prod",
+				"Resources": []any{
+					map[string]any{"ID": float64(123), "Type": "droplet"},
+					map[string]any{"ID": "vol-uuid", "Type": "volume"},
+					map[string]any{"ID": "cluster-1", "Type": "kubernetes"},
+				},
+			},
+			mockSetup: func(tags *MockTagsService, k8s *MockKubernetesService) {
+				tags.EXPECT().
+					TagResources(gomock.Any(), "prod", &godo.TagResourcesRequest{Resources: []godo.Resource{
+						{ID: "123", Type: godo.DropletResourceType},
+						{ID: "vol-uuid", Type: godo.VolumeResourceType},
+					}}).
+					Return(&godo.Response{}, nil).
+					Times(1)
+				k8s.EXPECT().
+					Get(gomock.Any(), "cluster-1").
+					Return(&godo.KubernetesCluster{ID: "cluster-1", Name: "prod-cluster", Tags: []string{"k8s", "k8s:cluster-1"}}, &godo.Response{}, nil).
+					Times(1)
+				k8s.EXPECT().
+					Update(gomock.Any(), "cluster-1", &godo.KubernetesClusterUpdateRequest{Name: "prod-cluster", Tags: []string{"k8s", "k8s:cluster-1", "prod"}}).
+					Return(&godo.KubernetesCluster{ID: "cluster-1"}, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectText: "Tagged 3 resources with prod",
+		},
+		{
+			name: "API error",
+			args: map[string]any{
+				"Name":      "prod",
+				"Resources": []any{map[string]any{"ID": "db-uuid", "Type": "database"}},
+			},
+			mockSetup: func(tags *MockTagsService, _ *MockKubernetesService) {
+				tags.EXPECT().
+					TagResources(gomock.Any(), "prod", gomock.Any()).
+					Return(nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "Unsupported resource type",
+			args: map[string]any{
+				"Name":      "prod",
+				"Resources": []any{map[string]any{"ID": "app-1", "Type": "app"}},
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing resources",
+			args:        map[string]any{"Name": "prod"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTags := NewMockTagsService(ctrl)
+			mockKubernetes := NewMockKubernetesService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockTags, mockKubernetes)
+			}
+			tool := setupTagToolsWithMocks(mockTags, mockKubernetes)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.tagResources(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			require.Equal(t, tc.expectText, resp.Content[0].(mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestTagTools_untagResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTags := NewMockTagsService(ctrl)
+	mockKubernetes := NewMockKubernetesService(ctrl)
+	mockTags.EXPECT().
+		UntagResources(gomock.Any(), "prod", &godo.UntagResourcesRequest{Resources: []godo.Resource{{ID: "42", Type: godo.ImageResourceType}}}).
+		Return(&godo.Response{}, nil).
+		Times(1)
+	mockKubernetes.EXPECT().
+		Get(gomock.Any(), "cluster-1").
+		Return(&godo.KubernetesCluster{ID: "cluster-1", Name: "prod-cluster", Tags: []string{"k8s", "prod"}}, &godo.Response{}, nil).
+		Times(1)
+	mockKubernetes.EXPECT().
+		Update(gomock.Any(), "cluster-1", &godo.KubernetesClusterUpdateRequest{Name: "prod-cluster", Tags: []string{"k8s"}}).
+		Return(&godo.KubernetesCluster{ID: "cluster-1"}, &godo.Response{}, nil).
+		Times(1)
+	tool := setupTagToolsWithMocks(mockTags, mockKubernetes)
+
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Name": "prod",
+		"Resources": []any{
+			map[string]any{"ID": "42", "Type": "image"},
+			map[string]any{"ID": "cluster-1", "Type": "kubernetes"},
+		},
+	}}}
+	resp, err := tool.untagResources(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, "Removed tag prod from 2 resources", resp.Content[0].(mcp.TextContent).Text)
+}
+
+func TestTagTools_untagClusterLastTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var requests []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, strings.TrimSpace(r.Method+" "+r.URL.Path+" "+string(body)))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kubernetes_cluster": {"id": "cluster-1", "name": "prod-cluster", "tags": []}}`))
+	}))
+	defer apiServer.Close()
+
+	client, err := godo.New(apiServer.Client(), godo.SetBaseURL(apiServer.URL+"/"))
+	require.NoError(t, err)
+	mockKubernetes := NewMockKubernetesService(ctrl)
+	mockKubernetes.EXPECT().
+		Get(gomock.Any(), "cluster-1").
+		Return(&godo.KubernetesCluster{ID: "cluster-1", Name: "prod-cluster", Tags: []string{"prod"}}, &godo.Response{}, nil).
+		Times(1)
+	client.Kubernetes = mockKubernetes
+	tool := NewTagTools(client)
+
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Name":      "prod",
+		"Resources": []any{map[string]any{"ID": "cluster-1", "Type": "kubernetes"}},
+	}}}
+	resp, err := tool.untagResources(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, []string{`PUT /v2/kubernetes/clusters/cluster-1 {"name":"prod-cluster","tags":[]}`}, requests)
+}
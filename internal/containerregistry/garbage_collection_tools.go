@@ -0,0 +1,437 @@
+package containerregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultKeepLast = 10
+	maxPageSize     = 200
+)
+
+// garbageCollectionTypes maps the GC type argument to the API garbage collection type.
+var garbageCollectionTypes = map[string]godo.GarbageCollectionType{
+	"untagged-manifests-and-unreferenced-blobs": godo.GCTypeUntaggedManifestsAndUnreferencedBlobs,
+	"untagged-manifests-only":                   godo.GCTypeUntaggedManifestsOnly,
+	"unreferenced-blobs-only":                   godo.GCTypeUnreferencedBlobsOnly,
+}
+
+// GarbageCollectionTool provides garbage collection and tag retention tools for a container registry
+type GarbageCollectionTool struct {
+	client *godo.Client
+}
+
+// NewGarbageCollectionTool creates a new garbage collection tool
+func NewGarbageCollectionTool(client *godo.Client) *GarbageCollectionTool {
+	return &GarbageCollectionTool{
+		client: client,
+	}
+}
+
+// retentionPolicy describes which tags of a repository are kept.
+type retentionPolicy struct {
+	KeepLast      int      `json:"keep_last"`
+	OlderThanDays int      `json:"older_than_days,omitempty"`
+	KeepTags      []string `json:"keep_tags,omitempty"`
+	Repositories  []string `json:"repositories,omitempty"`
+}
+
+// repositoryRetention is the outcome of a retention policy for a single repository.
+type repositoryRetention struct {
+	Repository string   `json:"repository"`
+	Kept       []string `json:"kept"`
+	Deleted    []string `json:"deleted"`
+	Failed     []string `json:"failed,omitempty"`
+	FreedBytes uint64   `json:"freed_bytes_estimate"`
+
+	// sizes holds the compressed size of each deleted tag, to correct FreedBytes when a deletion fails.
+	sizes map[string]uint64
+}
+
+// retentionPlan is the outcome of a retention policy for a registry.
+type retentionPlan struct {
+	Registry     string                 `json:"registry"`
+	Policy       retentionPolicy        `json:"policy"`
+	Repositories []*repositoryRetention `json:"repositories"`
+	TotalDeleted int                    `json:"total_deleted"`
+	TotalFailed  int                    `json:"total_failed,omitempty"`
+	FreedBytes   uint64                 `json:"freed_bytes_estimate"`
+	Errors       []string               `json:"errors,omitempty"`
+}
+
+// newRetentionPolicy reads a retention policy from the tool arguments.
+func newRetentionPolicy(args map[string]any) (retentionPolicy, error) {
+	policy := retentionPolicy{KeepLast: defaultKeepLast}
+	if v, ok := args["KeepLast"].(float64); ok {
+		if v < 0 {
+			return policy, fmt.Errorf("KeepLast must not be negative")
+		}
+		policy.KeepLast = int(v)
+	}
+	if v, ok := args["OlderThanDays"].(float64); ok && v > 0 {
+		policy.OlderThanDays = int(v)
+	}
+	if v, ok := args["KeepTags"].([]any); ok {
+		for _, tag := range v {
+			if s, ok := tag.(string); ok && s != "" {
+				policy.KeepTags = append(policy.KeepTags, s)
+			}
+		}
+	}
+	if v, ok := args["Repositories"].([]any); ok {
+		for _, repo := range v {
+			if s, ok := repo.(string); ok && s != "" {
+				policy.Repositories = append(policy.Repositories, s)
+			}
+		}
+	}
+	return policy, nil
+}
+
+// applyRetention splits the tags of a repository into kept and deleted tags. The newest KeepLast tags are always
+// kept, as are tags listed in KeepTags and, when OlderThanDays is set, tags updated more recently than that.
+func applyRetention(repository string, tags []*godo.RepositoryTag, policy retentionPolicy, now time.Time) *repositoryRetention {
+	sorted := make([]*godo.RepositoryTag, len(tags))
+	copy(sorted, tags)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].UpdatedAt.After(sorted[j].UpdatedAt)
+	})
+
+	keep := make(map[string]bool, len(policy.KeepTags))
+	for _, tag := range policy.KeepTags {
+		keep[tag] = true
+	}
+	cutoff := now.AddDate(0, 0, -policy.OlderThanDays)
+
+	result := &repositoryRetention{Repository: repository, Kept: []string{}, Deleted: []string{}, sizes: map[string]uint64{}}
+	for i, tag := range sorted {
+		if i < policy.KeepLast || keep[tag.Tag] || (policy.OlderThanDays > 0 && tag.UpdatedAt.After(cutoff)) {
+			result.Kept = append(result.Kept, tag.Tag)
+			continue
+		}
+		result.Deleted = append(result.Deleted, tag.Tag)
+		result.FreedBytes += tag.CompressedSizeBytes
+		result.sizes[tag.Tag] = tag.CompressedSizeBytes
+	}
+	return result
+}
+
+// listAllRepositories lists every repository of a registry.
+func (g *GarbageCollectionTool) listAllRepositories(ctx context.Context, registry string) ([]string, error) {
+	var names []string
+	opt := &godo.TokenListOptions{Page: 1, PerPage: maxPageSize}
+	for {
+		repositories, resp, err := g.client.Registry.ListRepositoriesV2(ctx, registry, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, repository := range repositories {
+			names = append(names, repository.Name)
+		}
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+		opt.Page = page + 1
+	}
+	return names, nil
+}
+
+// listAllTags lists every tag of a repository.
+func (g *GarbageCollectionTool) listAllTags(ctx context.Context, registry, repository string) ([]*godo.RepositoryTag, error) {
+	var tags []*godo.RepositoryTag
+	opt := &godo.ListOptions{Page: 1, PerPage: maxPageSize}
+	for {
+		page, resp, err := g.client.Registry.ListRepositoryTags(ctx, registry, repository, opt)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, page...)
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+		current, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+		opt.Page = current + 1
+	}
+	return tags, nil
+}
+
+// planRetention computes which tags a retention policy deletes across the repositories of a registry.
+func (g *GarbageCollectionTool) planRetention(ctx context.Context, registry string, policy retentionPolicy) (*retentionPlan, error) {
+	repositories := policy.Repositories
+	if len(repositories) == 0 {
+		var err error
+		repositories, err = g.listAllRepositories(ctx, registry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	plan := &retentionPlan{Registry: registry, Policy: policy, Repositories: []*repositoryRetention{}}
+	for _, repository := range repositories {
+		tags, err := g.listAllTags(ctx, registry, repository)
+		if err != nil {
+			return nil, fmt.Errorf("listing tags of %s: %w", repository, err)
+		}
+		result := applyRetention(repository, tags, policy, now)
+		plan.Repositories = append(plan.Repositories, result)
+		plan.TotalDeleted += len(result.Deleted)
+		plan.FreedBytes += result.FreedBytes
+	}
+	return plan, nil
+}
+
+// previewRetention shows which tags a retention policy would delete, without deleting anything
+func (g *GarbageCollectionTool) previewRetention(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	policy, err := newRetentionPolicy(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	name, err := registryName(ctx, g.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	plan, err := g.planRetention(ctx, name, policy)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonPlan, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonPlan)), nil
+}
+
+// applyRetentionPolicy deletes the tags selected by a retention policy and optionally starts a garbage collection
+func (g *GarbageCollectionTool) applyRetentionPolicy(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	policy, err := newRetentionPolicy(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	startGC, _ := args["StartGarbageCollection"].(bool)
+	name, err := registryName(ctx, g.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	plan, err := g.planRetention(ctx, name, policy)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	// The plan only lists the tags to delete; from here on Deleted, TotalDeleted and FreedBytes count the tags
+	// that were actually deleted, and the others are reported as failed.
+	plan.TotalDeleted, plan.FreedBytes = 0, 0
+	for _, repository := range plan.Repositories {
+		planned := repository.Deleted
+		repository.Deleted, repository.FreedBytes = []string{}, 0
+		for _, tag := range planned {
+			if _, err := g.client.Registry.DeleteTag(ctx, name, repository.Repository, tag); err != nil {
+				repository.Failed = append(repository.Failed, tag)
+				plan.Errors = append(plan.Errors, fmt.Sprintf("%s:%s: %v", repository.Repository, tag, err))
+				continue
+			}
+			repository.Deleted = append(repository.Deleted, tag)
+			repository.FreedBytes += repository.sizes[tag]
+		}
+		plan.TotalDeleted += len(repository.Deleted)
+		plan.TotalFailed += len(repository.Failed)
+		plan.FreedBytes += repository.FreedBytes
+	}
+
+	result := struct {
+		*retentionPlan
+		GarbageCollection *godo.GarbageCollection `json:"garbage_collection,omitempty"`
+	}{retentionPlan: plan}
+	if startGC && plan.TotalDeleted > 0 {
+		gc, _, err := g.client.Registry.StartGarbageCollection(ctx, name, &godo.StartGarbageCollectionRequest{
+			Type: godo.GCTypeUntaggedManifestsAndUnreferencedBlobs,
+		})
+		if err != nil {
+			plan.Errors = append(plan.Errors, fmt.Sprintf("starting garbage collection: %v", err))
+		}
+		result.GarbageCollection = gc
+	}
+
+	jsonResult, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResult)), nil
+}
+
+// startGarbageCollection starts a garbage collection on the registry
+func (g *GarbageCollectionTool) startGarbageCollection(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	gcTypeArg, ok := args["Type"].(string)
+	if !ok || gcTypeArg == "" {
+		gcTypeArg = "untagged-manifests-and-unreferenced-blobs"
+	}
+	gcType, ok := garbageCollectionTypes[gcTypeArg]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported garbage collection type: %s", gcTypeArg)), nil
+	}
+	name, err := registryName(ctx, g.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	gc, _, err := g.client.Registry.StartGarbageCollection(ctx, name, &godo.StartGarbageCollectionRequest{Type: gcType})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonGC, err := json.MarshalIndent(gc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonGC)), nil
+}
+
+// getGarbageCollection fetches the active garbage collection of the registry
+func (g *GarbageCollectionTool) getGarbageCollection(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, err := registryName(ctx, g.client, req.GetArguments())
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	gc, _, err := g.client.Registry.GetGarbageCollection(ctx, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonGC, err := json.MarshalIndent(gc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonGC)), nil
+}
+
+// listGarbageCollections lists past and active garbage collections of the registry
+func (g *GarbageCollectionTool) listGarbageCollections(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, err := registryName(ctx, g.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	page, perPage := pageArgs(args)
+
+	gcs, _, err := g.client.Registry.ListGarbageCollections(ctx, name, &godo.ListOptions{Page: page, PerPage: perPage})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonGCs, err := json.MarshalIndent(gcs, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonGCs)), nil
+}
+
+// cancelGarbageCollection cancels an active garbage collection
+func (g *GarbageCollectionTool) cancelGarbageCollection(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	uuid, ok := args["UUID"].(string)
+	if !ok || uuid == "" {
+		return mcp.NewToolResultError("Garbage collection UUID is required"), nil
+	}
+	name, err := registryName(ctx, g.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	gc, _, err := g.client.Registry.UpdateGarbageCollection(ctx, name, uuid, &godo.UpdateGarbageCollectionRequest{Cancel: true})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonGC, err := json.MarshalIndent(gc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonGC)), nil
+}
+
+// retentionPolicyOptions returns the tool options describing a retention policy
+func retentionPolicyOptions() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+		mcp.WithNumber("KeepLast", mcp.DefaultNumber(defaultKeepLast), mcp.Description("Number of most recently updated tags to keep per repository")),
+		mcp.WithNumber("OlderThanDays", mcp.Description("Only delete tags last updated more than this many days ago")),
+		mcp.WithArray("KeepTags", mcp.Description("Tags that are never deleted (e.g., 'latest', 'stable')"), mcp.Items(map[string]any{
+			"type":        "string",
+			"description": "Tag to keep",
+		})),
+		mcp.WithArray("Repositories", mcp.Description("Repositories the policy applies to. Defaults to every repository."), mcp.Items(map[string]any{
+			"type":        "string",
+			"description": "Name of a repository",
+		})),
+	}
+}
+
+// Tools returns a list of tool functions
+func (g *GarbageCollectionTool) Tools() []server.ServerTool {
+	previewOptions := append([]mcp.ToolOption{
+		mcp.WithDescription("Preview a tag retention policy, e.g. keep the last 10 tags per repository. Lists the tags that would be kept and deleted per repository without deleting anything."),
+	}, retentionPolicyOptions()...)
+
+	applyOptions := append([]mcp.ToolOption{
+		mcp.WithDescription("Apply a tag retention policy, deleting the tags that registry-retention-preview lists. Run the preview first."),
+		mcp.WithBoolean("StartGarbageCollection", mcp.DefaultBool(false), mcp.Description("Start a garbage collection after deleting tags to free the storage")),
+	}, retentionPolicyOptions()...)
+
+	return []server.ServerTool{
+		{
+			Handler: g.startGarbageCollection,
+			Tool: mcp.NewTool("registry-garbage-collection-start",
+				mcp.WithDescription("Start a garbage collection to free storage used by deleted tags and manifests. The registry is read-only while it runs."),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithString("Type", mcp.DefaultString("untagged-manifests-and-unreferenced-blobs"), mcp.Description("What to collect: 'untagged-manifests-and-unreferenced-blobs', 'untagged-manifests-only' or 'unreferenced-blobs-only'")),
+			),
+		},
+		{
+			Handler: g.getGarbageCollection,
+			Tool: mcp.NewTool("registry-garbage-collection-get",
+				mcp.WithDescription("Get the status of the active garbage collection"),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+			),
+		},
+		{
+			Handler: g.listGarbageCollections,
+			Tool: mcp.NewTool("registry-garbage-collection-list",
+				mcp.WithDescription("List past and active garbage collections with the number of blobs deleted and bytes freed"),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: g.cancelGarbageCollection,
+			Tool: mcp.NewTool("registry-garbage-collection-cancel",
+				mcp.WithDescription("Cancel an active garbage collection"),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithString("UUID", mcp.Required(), mcp.Description("UUID of the garbage collection")),
+			),
+		},
+		{
+			Handler: g.previewRetention,
+			Tool:    mcp.NewTool("registry-retention-preview", previewOptions...),
+		},
+		{
+			Handler: g.applyRetentionPolicy,
+			Tool:    mcp.NewTool("registry-retention-apply", applyOptions...),
+		},
+	}
+}
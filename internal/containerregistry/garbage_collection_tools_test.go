@@ -0,0 +1,254 @@
+package containerregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupGarbageCollectionToolWithMock(registry *MockRegistryService) *GarbageCollectionTool {
+	client := &godo.Client{}
+	client.Registry = registry
+	return NewGarbageCollectionTool(client)
+}
+
+func testRepositoryTags(now time.Time) []*godo.RepositoryTag {
+	return []*godo.RepositoryTag{
+		{Tag: "v1", UpdatedAt: now.AddDate(0, 0, -40), CompressedSizeBytes: 100},
+		{Tag: "latest", UpdatedAt: now.AddDate(0, 0, -1), CompressedSizeBytes: 300},
+		{Tag: "v2", UpdatedAt: now.AddDate(0, 0, -30), CompressedSizeBytes: 200},
+		{Tag: "v3", UpdatedAt: now.AddDate(0, 0, -2), CompressedSizeBytes: 300},
+	}
+}
+
+func TestApplyRetention(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name          string
+		policy        retentionPolicy
+		expectKept    []string
+		expectDeleted []string
+		expectFreed   uint64
+	}{
+		{
+			name:          "Keep last two",
+			policy:        retentionPolicy{KeepLast: 2},
+			expectKept:    []string{"latest", "v3"},
+			expectDeleted: []string{"v2", "v1"},
+			expectFreed:   300,
+		},
+		{
+			name:          "Keep listed tags",
+			policy:        retentionPolicy{KeepLast: 1, KeepTags: []string{"v1"}},
+			expectKept:    []string{"latest", "v1"},
+			expectDeleted: []string{"v3", "v2"},
+			expectFreed:   500,
+		},
+		{
+			name:          "Only tags older than 35 days",
+			policy:        retentionPolicy{KeepLast: 0, OlderThanDays: 35},
+			expectKept:    []string{"latest", "v3", "v2"},
+			expectDeleted: []string{"v1"},
+			expectFreed:   100,
+		},
+		{
+			name:          "Fewer tags than KeepLast",
+			policy:        retentionPolicy{KeepLast: 10},
+			expectKept:    []string{"latest", "v3", "v2", "v1"},
+			expectDeleted: []string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := applyRetention("web", testRepositoryTags(now), tc.policy, now)
+			require.Equal(t, tc.expectKept, result.Kept)
+			require.Equal(t, tc.expectDeleted, result.Deleted)
+			require.Equal(t, tc.expectFreed, result.FreedBytes)
+		})
+	}
+}
+
+func TestGarbageCollectionTool_previewRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	mockRegistry := NewMockRegistryService(ctrl)
+	mockRegistry.EXPECT().
+		ListRepositoriesV2(gomock.Any(), "acme", gomock.Any()).
+		Return([]*godo.RepositoryV2{{Name: "web"}, {Name: "worker"}}, &godo.Response{}, nil).
+		Times(1)
+	mockRegistry.EXPECT().
+		ListRepositoryTags(gomock.Any(), "acme", "web", gomock.Any()).
+		Return(testRepositoryTags(now), &godo.Response{}, nil).
+		Times(1)
+	mockRegistry.EXPECT().
+		ListRepositoryTags(gomock.Any(), "acme", "worker", gomock.Any()).
+		Return(testRepositoryTags(now)[:1], &godo.Response{}, nil).
+		Times(1)
+	tool := setupGarbageCollectionToolWithMock(mockRegistry)
+
+	resp, err := tool.previewRetention(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"RegistryName": "acme",
+		"KeepLast":     float64(3),
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var plan retentionPlan
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &plan))
+	require.Equal(t, 1, plan.TotalDeleted)
+	require.Equal(t, []string{"v1"}, plan.Repositories[0].Deleted)
+	require.Empty(t, plan.Repositories[1].Deleted)
+
+	resp, err = tool.previewRetention(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"RegistryName": "acme",
+		"KeepLast":     float64(-1),
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestGarbageCollectionTool_applyRetentionPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	mockRegistry := NewMockRegistryService(ctrl)
+	mockRegistry.EXPECT().
+		ListRepositoryTags(gomock.Any(), "acme", "web", gomock.Any()).
+		Return(testRepositoryTags(now), &godo.Response{}, nil).
+		Times(1)
+	mockRegistry.EXPECT().
+		DeleteTag(gomock.Any(), "acme", "web", "v2").
+		Return(nil, nil).
+		Times(1)
+	mockRegistry.EXPECT().
+		DeleteTag(gomock.Any(), "acme", "web", "v1").
+		Return(nil, errors.New("api error")).
+		Times(1)
+	mockRegistry.EXPECT().
+		StartGarbageCollection(gomock.Any(), "acme", &godo.StartGarbageCollectionRequest{Type: godo.GCTypeUntaggedManifestsAndUnreferencedBlobs}).
+		Return(&godo.GarbageCollection{UUID: "gc-1", Status: "requested"}, nil, nil).
+		Times(1)
+	tool := setupGarbageCollectionToolWithMock(mockRegistry)
+
+	resp, err := tool.applyRetentionPolicy(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"RegistryName":           "acme",
+		"Repositories":           []any{"web"},
+		"KeepLast":               float64(2),
+		"StartGarbageCollection": true,
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	text := resp.Content[0].(mcp.TextContent).Text
+	require.Contains(t, text, `"uuid": "gc-1"`)
+	require.Contains(t, text, "web:v1: api error")
+	var result retentionPlan
+	require.NoError(t, json.Unmarshal([]byte(text), &result))
+	require.Equal(t, 1, result.TotalDeleted)
+	require.Equal(t, 1, result.TotalFailed)
+	require.Equal(t, uint64(200), result.FreedBytes)
+	require.Equal(t, []string{"v2"}, result.Repositories[0].Deleted)
+	require.Equal(t, []string{"v1"}, result.Repositories[0].Failed)
+}
+
+func TestGarbageCollectionTool_applyRetentionPolicyAllDeletesFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	mockRegistry := NewMockRegistryService(ctrl)
+	mockRegistry.EXPECT().
+		ListRepositoryTags(gomock.Any(), "acme", "web", gomock.Any()).
+		Return(testRepositoryTags(now), &godo.Response{}, nil).
+		Times(1)
+	mockRegistry.EXPECT().
+		DeleteTag(gomock.Any(), "acme", "web", gomock.Any()).
+		Return(nil, errors.New("api error")).
+		Times(2)
+	tool := setupGarbageCollectionToolWithMock(mockRegistry)
+
+	resp, err := tool.applyRetentionPolicy(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"RegistryName":           "acme",
+		"Repositories":           []any{"web"},
+		"KeepLast":               float64(2),
+		"StartGarbageCollection": true,
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var result struct {
+		retentionPlan
+		GarbageCollection *godo.GarbageCollection `json:"garbage_collection"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &result))
+	require.Zero(t, result.TotalDeleted)
+	require.Equal(t, 2, result.TotalFailed)
+	require.Zero(t, result.FreedBytes)
+	require.Empty(t, result.Repositories[0].Deleted)
+	require.Nil(t, result.GarbageCollection)
+}
+
+func TestGarbageCollectionTool_startGarbageCollection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockRegistryService)
+		expectError bool
+	}{
+		{
+			name: "Unreferenced blobs only",
+			args: map[string]any{"RegistryName": "acme", "Type": "unreferenced-blobs-only"},
+			mockSetup: func(m *MockRegistryService) {
+				m.EXPECT().
+					StartGarbageCollection(gomock.Any(), "acme", &godo.StartGarbageCollectionRequest{Type: godo.GCTypeUnreferencedBlobsOnly}).
+					Return(&godo.GarbageCollection{UUID: "gc-1"}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"RegistryName": "acme"},
+			mockSetup: func(m *MockRegistryService) {
+				m.EXPECT().
+					StartGarbageCollection(gomock.Any(), "acme", gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported type",
+			args:        map[string]any{"RegistryName": "acme", "Type": "everything"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRegistry := NewMockRegistryService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockRegistry)
+			}
+			tool := setupGarbageCollectionToolWithMock(mockRegistry)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.startGarbageCollection(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+package containerregistry
+
+//go:generate mockgen -destination=./mocks.go -package containerregistry github.com/digitalocean/godo RegistryService
@@ -0,0 +1,331 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/digitalocean/godo (interfaces: RegistryService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=./mocks.go -package containerregistry github.com/digitalocean/godo RegistryService
+//
+
+// Package containerregistry is a generated GoMock package.
+package containerregistry
+
+import (
+	context "context"
+	reflect "reflect"
+
+	godo "github.com/digitalocean/godo"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRegistryService is a mock of RegistryService interface.
+type MockRegistryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRegistryServiceMockRecorder is the mock recorder for MockRegistryService.
+type MockRegistryServiceMockRecorder struct {
+	mock *MockRegistryService
+}
+
+// NewMockRegistryService creates a new mock instance.
+func NewMockRegistryService(ctrl *gomock.Controller) *MockRegistryService {
+	mock := &MockRegistryService{ctrl: ctrl}
+	mock.recorder = &MockRegistryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistryService) EXPECT() *MockRegistryServiceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRegistryService) Create(arg0 context.Context, arg1 *godo.RegistryCreateRequest) (*godo.Registry, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Registry)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRegistryServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRegistryService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockRegistryService) Delete(arg0 context.Context) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRegistryServiceMockRecorder) Delete(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRegistryService)(nil).Delete), arg0)
+}
+
+// DeleteManifest mocks base method.
+func (m *MockRegistryService) DeleteManifest(arg0 context.Context, arg1, arg2, arg3 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManifest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManifest indicates an expected call of DeleteManifest.
+func (mr *MockRegistryServiceMockRecorder) DeleteManifest(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManifest", reflect.TypeOf((*MockRegistryService)(nil).DeleteManifest), arg0, arg1, arg2, arg3)
+}
+
+// DeleteTag mocks base method.
+func (m *MockRegistryService) DeleteTag(arg0 context.Context, arg1, arg2, arg3 string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockRegistryServiceMockRecorder) DeleteTag(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockRegistryService)(nil).DeleteTag), arg0, arg1, arg2, arg3)
+}
+
+// DockerCredentials mocks base method.
+func (m *MockRegistryService) DockerCredentials(arg0 context.Context, arg1 *godo.RegistryDockerCredentialsRequest) (*godo.DockerCredentials, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DockerCredentials", arg0, arg1)
+	ret0, _ := ret[0].(*godo.DockerCredentials)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DockerCredentials indicates an expected call of DockerCredentials.
+func (mr *MockRegistryServiceMockRecorder) DockerCredentials(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DockerCredentials", reflect.TypeOf((*MockRegistryService)(nil).DockerCredentials), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockRegistryService) Get(arg0 context.Context) (*godo.Registry, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*godo.Registry)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockRegistryServiceMockRecorder) Get(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRegistryService)(nil).Get), arg0)
+}
+
+// GetGarbageCollection mocks base method.
+func (m *MockRegistryService) GetGarbageCollection(arg0 context.Context, arg1 string) (*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGarbageCollection", arg0, arg1)
+	ret0, _ := ret[0].(*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGarbageCollection indicates an expected call of GetGarbageCollection.
+func (mr *MockRegistryServiceMockRecorder) GetGarbageCollection(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGarbageCollection", reflect.TypeOf((*MockRegistryService)(nil).GetGarbageCollection), arg0, arg1)
+}
+
+// GetOptions mocks base method.
+func (m *MockRegistryService) GetOptions(arg0 context.Context) (*godo.RegistryOptions, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptions", arg0)
+	ret0, _ := ret[0].(*godo.RegistryOptions)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOptions indicates an expected call of GetOptions.
+func (mr *MockRegistryServiceMockRecorder) GetOptions(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptions", reflect.TypeOf((*MockRegistryService)(nil).GetOptions), arg0)
+}
+
+// GetSubscription mocks base method.
+func (m *MockRegistryService) GetSubscription(arg0 context.Context) (*godo.RegistrySubscription, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", arg0)
+	ret0, _ := ret[0].(*godo.RegistrySubscription)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockRegistryServiceMockRecorder) GetSubscription(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockRegistryService)(nil).GetSubscription), arg0)
+}
+
+// ListGarbageCollections mocks base method.
+func (m *MockRegistryService) ListGarbageCollections(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGarbageCollections", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListGarbageCollections indicates an expected call of ListGarbageCollections.
+func (mr *MockRegistryServiceMockRecorder) ListGarbageCollections(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGarbageCollections", reflect.TypeOf((*MockRegistryService)(nil).ListGarbageCollections), arg0, arg1, arg2)
+}
+
+// ListRepositories mocks base method.
+func (m *MockRegistryService) ListRepositories(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]*godo.Repository, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositories", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.Repository)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositories indicates an expected call of ListRepositories.
+func (mr *MockRegistryServiceMockRecorder) ListRepositories(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositories", reflect.TypeOf((*MockRegistryService)(nil).ListRepositories), arg0, arg1, arg2)
+}
+
+// ListRepositoriesV2 mocks base method.
+func (m *MockRegistryService) ListRepositoriesV2(arg0 context.Context, arg1 string, arg2 *godo.TokenListOptions) ([]*godo.RepositoryV2, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoriesV2", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*godo.RepositoryV2)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoriesV2 indicates an expected call of ListRepositoriesV2.
+func (mr *MockRegistryServiceMockRecorder) ListRepositoriesV2(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoriesV2", reflect.TypeOf((*MockRegistryService)(nil).ListRepositoriesV2), arg0, arg1, arg2)
+}
+
+// ListRepositoryManifests mocks base method.
+func (m *MockRegistryService) ListRepositoryManifests(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]*godo.RepositoryManifest, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryManifests", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*godo.RepositoryManifest)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoryManifests indicates an expected call of ListRepositoryManifests.
+func (mr *MockRegistryServiceMockRecorder) ListRepositoryManifests(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryManifests", reflect.TypeOf((*MockRegistryService)(nil).ListRepositoryManifests), arg0, arg1, arg2, arg3)
+}
+
+// ListRepositoryTags mocks base method.
+func (m *MockRegistryService) ListRepositoryTags(arg0 context.Context, arg1, arg2 string, arg3 *godo.ListOptions) ([]*godo.RepositoryTag, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRepositoryTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*godo.RepositoryTag)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRepositoryTags indicates an expected call of ListRepositoryTags.
+func (mr *MockRegistryServiceMockRecorder) ListRepositoryTags(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRepositoryTags", reflect.TypeOf((*MockRegistryService)(nil).ListRepositoryTags), arg0, arg1, arg2, arg3)
+}
+
+// StartGarbageCollection mocks base method.
+func (m *MockRegistryService) StartGarbageCollection(arg0 context.Context, arg1 string, arg2 ...*godo.StartGarbageCollectionRequest) (*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StartGarbageCollection", varargs...)
+	ret0, _ := ret[0].(*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// StartGarbageCollection indicates an expected call of StartGarbageCollection.
+func (mr *MockRegistryServiceMockRecorder) StartGarbageCollection(arg0, arg1 any, arg2 ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartGarbageCollection", reflect.TypeOf((*MockRegistryService)(nil).StartGarbageCollection), varargs...)
+}
+
+// UpdateGarbageCollection mocks base method.
+func (m *MockRegistryService) UpdateGarbageCollection(arg0 context.Context, arg1, arg2 string, arg3 *godo.UpdateGarbageCollectionRequest) (*godo.GarbageCollection, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGarbageCollection", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*godo.GarbageCollection)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateGarbageCollection indicates an expected call of UpdateGarbageCollection.
+func (mr *MockRegistryServiceMockRecorder) UpdateGarbageCollection(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGarbageCollection", reflect.TypeOf((*MockRegistryService)(nil).UpdateGarbageCollection), arg0, arg1, arg2, arg3)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockRegistryService) UpdateSubscription(arg0 context.Context, arg1 *godo.RegistrySubscriptionUpdateRequest) (*godo.RegistrySubscription, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*godo.RegistrySubscription)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockRegistryServiceMockRecorder) UpdateSubscription(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockRegistryService)(nil).UpdateSubscription), arg0, arg1)
+}
+
+// ValidateName mocks base method.
+func (m *MockRegistryService) ValidateName(arg0 context.Context, arg1 *godo.RegistryValidateNameRequest) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateName", arg0, arg1)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateName indicates an expected call of ValidateName.
+func (mr *MockRegistryServiceMockRecorder) ValidateName(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateName", reflect.TypeOf((*MockRegistryService)(nil).ValidateName), arg0, arg1)
+}
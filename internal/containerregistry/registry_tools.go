@@ -0,0 +1,345 @@
+package containerregistry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultPageSize = 20
+	defaultPage     = 1
+)
+
+// RegistryTool provides container registry, repository and credential management tools
+type RegistryTool struct {
+	client *godo.Client
+}
+
+// NewRegistryTool creates a new registry tool
+func NewRegistryTool(client *godo.Client) *RegistryTool {
+	return &RegistryTool{
+		client: client,
+	}
+}
+
+// registryName returns the RegistryName argument, or the name of the account's registry when it is omitted.
+func registryName(ctx context.Context, client *godo.Client, args map[string]any) (string, error) {
+	if name, ok := args["RegistryName"].(string); ok && name != "" {
+		return name, nil
+	}
+	registry, _, err := client.Registry.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return registry.Name, nil
+}
+
+// pageArgs returns the Page and PerPage arguments, falling back to the defaults.
+func pageArgs(args map[string]any) (int, int) {
+	page := defaultPage
+	perPage := defaultPageSize
+	if v, ok := args["Page"].(float64); ok && int(v) > 0 {
+		page = int(v)
+	}
+	if v, ok := args["PerPage"].(float64); ok && int(v) > 0 {
+		perPage = int(v)
+	}
+	return page, perPage
+}
+
+// getRegistry fetches the account's container registry
+func (r *RegistryTool) getRegistry(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	registry, _, err := r.client.Registry.Get(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	subscription, _, err := r.client.Registry.GetSubscription(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonRegistry, err := json.MarshalIndent(struct {
+		*godo.Registry
+		Subscription *godo.RegistrySubscription `json:"subscription,omitempty"`
+	}{registry, subscription}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonRegistry)), nil
+}
+
+// getRegistryOptions lists the available subscription tiers and regions
+func (r *RegistryTool) getRegistryOptions(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	options, _, err := r.client.Registry.GetOptions(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonOptions, err := json.MarshalIndent(options, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonOptions)), nil
+}
+
+// createRegistry creates the account's container registry
+func (r *RegistryTool) createRegistry(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Registry name is required"), nil
+	}
+	tier, ok := args["SubscriptionTierSlug"].(string)
+	if !ok || tier == "" {
+		return mcp.NewToolResultError("Subscription tier is required"), nil
+	}
+	region, _ := args["Region"].(string)
+
+	registry, _, err := r.client.Registry.Create(ctx, &godo.RegistryCreateRequest{
+		Name:                 name,
+		SubscriptionTierSlug: tier,
+		Region:               region,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonRegistry, err := json.MarshalIndent(registry, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonRegistry)), nil
+}
+
+// deleteRegistry deletes the account's container registry and all of its repositories
+func (r *RegistryTool) deleteRegistry(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	_, err := r.client.Registry.Delete(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Registry deleted successfully"), nil
+}
+
+// getDockerCredentials fetches a Docker config file with credentials for the registry
+func (r *RegistryTool) getDockerCredentials(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	credentialsRequest := &godo.RegistryDockerCredentialsRequest{}
+	if v, ok := args["ReadWrite"].(bool); ok {
+		credentialsRequest.ReadWrite = v
+	}
+	if v, ok := args["ExpirySeconds"].(float64); ok && v > 0 {
+		expiry := int(v)
+		credentialsRequest.ExpirySeconds = &expiry
+	}
+
+	credentials, _, err := r.client.Registry.DockerCredentials(ctx, credentialsRequest)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText(string(credentials.DockerConfigJSON)), nil
+}
+
+// listRepositories lists the repositories of a registry
+func (r *RegistryTool) listRepositories(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, err := registryName(ctx, r.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	page, perPage := pageArgs(args)
+
+	repositories, _, err := r.client.Registry.ListRepositoriesV2(ctx, name, &godo.TokenListOptions{Page: page, PerPage: perPage})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonRepositories, err := json.MarshalIndent(repositories, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonRepositories)), nil
+}
+
+// listRepositoryTags lists the tags of a repository
+func (r *RegistryTool) listRepositoryTags(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	repository, ok := args["Repository"].(string)
+	if !ok || repository == "" {
+		return mcp.NewToolResultError("Repository is required"), nil
+	}
+	name, err := registryName(ctx, r.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	page, perPage := pageArgs(args)
+
+	tags, _, err := r.client.Registry.ListRepositoryTags(ctx, name, repository, &godo.ListOptions{Page: page, PerPage: perPage})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonTags, err := json.MarshalIndent(tags, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTags)), nil
+}
+
+// deleteRepositoryTag deletes a tag from a repository
+func (r *RegistryTool) deleteRepositoryTag(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	repository, ok := args["Repository"].(string)
+	if !ok || repository == "" {
+		return mcp.NewToolResultError("Repository is required"), nil
+	}
+	tag, ok := args["Tag"].(string)
+	if !ok || tag == "" {
+		return mcp.NewToolResultError("Tag is required"), nil
+	}
+	name, err := registryName(ctx, r.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	_, err = r.client.Registry.DeleteTag(ctx, name, repository, tag)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Tag deleted successfully. Run a garbage collection to free the storage."), nil
+}
+
+// listRepositoryManifests lists the manifests of a repository
+func (r *RegistryTool) listRepositoryManifests(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	repository, ok := args["Repository"].(string)
+	if !ok || repository == "" {
+		return mcp.NewToolResultError("Repository is required"), nil
+	}
+	name, err := registryName(ctx, r.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	page, perPage := pageArgs(args)
+
+	manifests, _, err := r.client.Registry.ListRepositoryManifests(ctx, name, repository, &godo.ListOptions{Page: page, PerPage: perPage})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonManifests, err := json.MarshalIndent(manifests, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonManifests)), nil
+}
+
+// deleteRepositoryManifest deletes a manifest, and all the tags pointing to it, from a repository
+func (r *RegistryTool) deleteRepositoryManifest(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	repository, ok := args["Repository"].(string)
+	if !ok || repository == "" {
+		return mcp.NewToolResultError("Repository is required"), nil
+	}
+	digest, ok := args["Digest"].(string)
+	if !ok || digest == "" {
+		return mcp.NewToolResultError("Manifest digest is required"), nil
+	}
+	name, err := registryName(ctx, r.client, args)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	_, err = r.client.Registry.DeleteManifest(ctx, name, repository, digest)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Manifest deleted successfully. Run a garbage collection to free the storage."), nil
+}
+
+// Tools returns a list of tool functions
+func (r *RegistryTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: r.getRegistry,
+			Tool: mcp.NewTool("registry-get",
+				mcp.WithDescription("Get the account's container registry, including its storage usage and subscription tier"),
+			),
+		},
+		{
+			Handler: r.getRegistryOptions,
+			Tool: mcp.NewTool("registry-options",
+				mcp.WithDescription("List the available container registry subscription tiers and regions"),
+			),
+		},
+		{
+			Handler: r.createRegistry,
+			Tool: mcp.NewTool("registry-create",
+				mcp.WithDescription("Create the account's container registry. An account has at most one registry."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Globally unique name of the registry")),
+				mcp.WithString("SubscriptionTierSlug", mcp.Required(), mcp.Description("Subscription tier: 'starter', 'basic' or 'professional'")),
+				mcp.WithString("Region", mcp.Description("Slug of the region (e.g., 'nyc3'). Defaults to a region near the account.")),
+			),
+		},
+		{
+			Handler: r.deleteRegistry,
+			Tool: mcp.NewTool("registry-delete",
+				mcp.WithDescription("Delete the account's container registry and all of its repositories"),
+			),
+		},
+		{
+			Handler: r.getDockerCredentials,
+			Tool: mcp.NewTool("registry-docker-credentials",
+				mcp.WithDescription("Get a Docker config.json with credentials for the container registry"),
+				mcp.WithBoolean("ReadWrite", mcp.DefaultBool(false), mcp.Description("Return credentials with push access instead of read-only access")),
+				mcp.WithNumber("ExpirySeconds", mcp.Description("Lifetime of the credentials in seconds. Credentials do not expire when omitted.")),
+			),
+		},
+		{
+			Handler: r.listRepositories,
+			Tool: mcp.NewTool("registry-repository-list",
+				mcp.WithDescription("List the repositories of the container registry with their tag and manifest counts"),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: r.listRepositoryTags,
+			Tool: mcp.NewTool("registry-repository-tag-list",
+				mcp.WithDescription("List the tags of a repository"),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithString("Repository", mcp.Required(), mcp.Description("Name of the repository")),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: r.deleteRepositoryTag,
+			Tool: mcp.NewTool("registry-repository-tag-delete",
+				mcp.WithDescription("Delete a tag from a repository. Run a garbage collection afterwards to free the storage."),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithString("Repository", mcp.Required(), mcp.Description("Name of the repository")),
+				mcp.WithString("Tag", mcp.Required(), mcp.Description("Tag to delete")),
+			),
+		},
+		{
+			Handler: r.listRepositoryManifests,
+			Tool: mcp.NewTool("registry-repository-manifest-list",
+				mcp.WithDescription("List the manifests of a repository with their digests, sizes and tags"),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithString("Repository", mcp.Required(), mcp.Description("Name of the repository")),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: r.deleteRepositoryManifest,
+			Tool: mcp.NewTool("registry-repository-manifest-delete",
+				mcp.WithDescription("Delete a manifest and all tags pointing to it. Run a garbage collection afterwards to free the storage."),
+				mcp.WithString("RegistryName", mcp.Description("Name of the registry. Defaults to the account's registry.")),
+				mcp.WithString("Repository", mcp.Required(), mcp.Description("Name of the repository")),
+				mcp.WithString("Digest", mcp.Required(), mcp.Description("Digest of the manifest (e.g., 'sha256:...')")),
+			),
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package containerregistry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupRegistryToolWithMock(registry *MockRegistryService) *RegistryTool {
+	client := &godo.Client{}
+	client.Registry = registry
+	return NewRegistryTool(client)
+}
+
+func TestRegistryTool_createRegistry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockRegistryService)
+		expectError bool
+	}{
+		{
+			name: "Successful create",
+			args: map[string]any{"Name": "acme", "SubscriptionTierSlug": "basic", "Region": "nyc3"},
+			mockSetup: func(m *MockRegistryService) {
+				m.EXPECT().
+					Create(gomock.Any(), &godo.RegistryCreateRequest{Name: "acme", SubscriptionTierSlug: "basic", Region: "nyc3"}).
+					Return(&godo.Registry{Name: "acme", Region: "nyc3"}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"Name": "acme", "SubscriptionTierSlug": "basic"},
+			mockSetup: func(m *MockRegistryService) {
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing tier",
+			args:        map[string]any{"Name": "acme"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRegistry := NewMockRegistryService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockRegistry)
+			}
+			tool := setupRegistryToolWithMock(mockRegistry)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createRegistry(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+		})
+	}
+}
+
+func TestRegistryTool_listRepositoryTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockRegistryService)
+		expectError bool
+	}{
+		{
+			name: "Explicit registry name",
+			args: map[string]any{"RegistryName": "acme", "Repository": "web"},
+			mockSetup: func(m *MockRegistryService) {
+				m.EXPECT().
+					ListRepositoryTags(gomock.Any(), "acme", "web", &godo.ListOptions{Page: 1, PerPage: 20}).
+					Return([]*godo.RepositoryTag{{Repository: "web", Tag: "v1"}}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "Defaults to the account's registry",
+			args: map[string]any{"Repository": "web", "Page": float64(2)},
+			mockSetup: func(m *MockRegistryService) {
+				m.EXPECT().
+					Get(gomock.Any()).
+					Return(&godo.Registry{Name: "acme"}, nil, nil).
+					Times(1)
+				m.EXPECT().
+					ListRepositoryTags(gomock.Any(), "acme", "web", &godo.ListOptions{Page: 2, PerPage: 20}).
+					Return([]*godo.RepositoryTag{{Repository: "web", Tag: "v1"}}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "No registry",
+			args: map[string]any{"Repository": "web"},
+			mockSetup: func(m *MockRegistryService) {
+				m.EXPECT().
+					Get(gomock.Any()).
+					Return(nil, nil, errors.New("not found")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing repository",
+			args:        map[string]any{"RegistryName": "acme"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRegistry := NewMockRegistryService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockRegistry)
+			}
+			tool := setupRegistryToolWithMock(mockRegistry)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.listRepositoryTags(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			var tags []*godo.RepositoryTag
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &tags))
+			require.Equal(t, "v1", tags[0].Tag)
+		})
+	}
+}
+
+func TestRegistryTool_deleteRepositoryManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRegistry := NewMockRegistryService(ctrl)
+	mockRegistry.EXPECT().
+		DeleteManifest(gomock.Any(), "acme", "web", "sha256:abc").
+		Return(nil, nil).
+		Times(1)
+	tool := setupRegistryToolWithMock(mockRegistry)
+
+	resp, err := tool.deleteRepositoryManifest(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"RegistryName": "acme",
+		"Repository":   "web",
+		"Digest":       "sha256:abc",
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.deleteRepositoryManifest(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"RegistryName": "acme",
+		"Repository":   "web",
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestRegistryTool_getDockerCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiry := 3600
+	mockRegistry := NewMockRegistryService(ctrl)
+	mockRegistry.EXPECT().
+		DockerCredentials(gomock.Any(), &godo.RegistryDockerCredentialsRequest{ReadWrite: true, ExpirySeconds: &expiry}).
+		Return(&godo.DockerCredentials{DockerConfigJSON: []byte(`{"auths":{}}`)}, nil, nil).
+		Times(1)
+	tool := setupRegistryToolWithMock(mockRegistry)
+
+	resp, err := tool.getDockerCredentials(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ReadWrite":     true,
+		"ExpirySeconds": float64(3600),
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, `{"auths":{}}`, resp.Content[0].(mcp.TextContent).Text)
+}
@@ -78,6 +78,7 @@ func (s *ClusterTool) createCluster(ctx context.Context, req mcp.CallToolRequest
 	region, _ := args["region"].(string)
 	size, _ := args["size"].(string)
 	numNodes, _ := args["num_nodes"].(float64) // JSON numbers are float64
+	projectID, _ := args["project_id"].(string)
 
 	tags := []string{}
 	if tagsRaw, ok := args["tags"].(string); ok && tagsRaw != "" {
@@ -97,6 +98,7 @@ func (s *ClusterTool) createCluster(ctx context.Context, req mcp.CallToolRequest
 		SizeSlug:   size,
 		NumNodes:   int(numNodes),
 		Tags:       tags,
+		ProjectID:  projectID,
 	}
 
 	cluster, _, err := s.client.Databases.Create(ctx, createReq)
@@ -360,6 +362,7 @@ func (s *ClusterTool) Tools() []server.ServerTool {
 				mcp.WithString("size", mcp.Required(), mcp.Description("The size slug (e.g., db-s-2vcpu-4gb)")),
 				mcp.WithNumber("num_nodes", mcp.Required(), mcp.Description("The number of nodes")),
 				mcp.WithString("tags", mcp.Description("Comma-separated tags to apply to the cluster")),
+				mcp.WithString("project_id", mcp.Description("The ID of the project to assign the cluster to (defaults to the default project)")),
 			),
 		},
 		{
@@ -97,6 +97,41 @@ func TestClusterTool_createCluster(t *testing.T) {
 	assert.Contains(t, getText(res), "api error")
 }
 
+func TestClusterTool_createClusterInProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDB := mocks.NewMockDatabasesService(ctrl)
+	created := &godo.Database{Name: "new-cluster"}
+	mockDB.EXPECT().Create(gomock.Any(), &godo.DatabaseCreateRequest{
+		Name:       "new-cluster",
+		EngineSlug: "pg",
+		Version:    "13",
+		Region:     "nyc1",
+		SizeSlug:   "db-s-1vcpu-1gb",
+		NumNodes:   2,
+		Tags:       []string{},
+		ProjectID:  "project-1",
+	}).Return(created, nil, nil)
+
+	client := &godo.Client{}
+	client.Databases = mockDB
+	ct := &ClusterTool{client: client}
+
+	args := map[string]interface{}{
+		"name":       "new-cluster",
+		"engine":     "pg",
+		"version":    "13",
+		"region":     "nyc1",
+		"size":       "db-s-1vcpu-1gb",
+		"num_nodes":  float64(2),
+		"project_id": "project-1",
+	}
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}}
+	res, err := ct.createCluster(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Contains(t, getText(res), "new-cluster")
+}
+
 func TestClusterTool_deleteCluster(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
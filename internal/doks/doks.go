@@ -108,6 +108,13 @@ func (d *DoksTool) createDOKSCluster(ctx context.Context, req mcp.CallToolReques
 		return mcp.NewToolResultErrorFromErr("failed to create cluster", err), nil
 	}
 
+	// Assign the cluster to the requested project, if any
+	if projectID, ok := req.GetArguments()["project_id"].(string); ok && projectID != "" {
+		if _, _, err := d.client.Projects.AssignResources(ctx, projectID, cluster.URN()); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("cluster %s created but project assignment failed", cluster.ID), err), nil
+		}
+	}
+
 	// Marshal the response
 	clusterJSON, err := json.MarshalIndent(cluster, "", "  ")
 	if err != nil {
@@ -653,6 +660,106 @@ func (d *DoksTool) recycleDOKSNodes(ctx context.Context, req mcp.CallToolRequest
 	return mcp.NewToolResultText(fmt.Sprintf("Successfully recycled %d nodes in node pool %s", len(nodeIDs), nodePoolID)), nil
 }
 
+// AddDOKSRegistry integrates the container registry with one or more clusters
+func (d *DoksTool) addDOKSRegistry(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	// Extract cluster IDs
+	clusterIDs := clusterIDsFromArgs(args)
+	if len(clusterIDs) == 0 {
+		return mcp.NewToolResultError("ClusterIDs is required and must be a non-empty array of strings"), nil
+	}
+
+	// Make the API call
+	_, err := d.client.Kubernetes.AddRegistry(ctx, &godo.KubernetesClusterRegistryRequest{
+		ClusterUUIDs: clusterIDs,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to add registry", err), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Container registry integrated with %d cluster(s): %s", len(clusterIDs), strings.Join(clusterIDs, ", "))), nil
+}
+
+// RemoveDOKSRegistry removes the container registry integration from one or more clusters
+func (d *DoksTool) removeDOKSRegistry(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+
+	// Extract cluster IDs
+	clusterIDs := clusterIDsFromArgs(args)
+	if len(clusterIDs) == 0 {
+		return mcp.NewToolResultError("ClusterIDs is required and must be a non-empty array of strings"), nil
+	}
+
+	// Make the API call
+	_, err := d.client.Kubernetes.RemoveRegistry(ctx, &godo.KubernetesClusterRegistryRequest{
+		ClusterUUIDs: clusterIDs,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("failed to remove registry", err), nil
+	}
+
+	return mcp.NewToolResultText(fmt.Sprintf("Container registry integration removed from %d cluster(s): %s", len(clusterIDs), strings.Join(clusterIDs, ", "))), nil
+}
+
+// registryIntegration reports whether a cluster can pull images from the container registry
+type registryIntegration struct {
+	ClusterID       string `json:"cluster_id"`
+	Name            string `json:"name"`
+	Region          string `json:"region"`
+	RegistryEnabled bool   `json:"registry_enabled"`
+}
+
+// ListDOKSRegistryIntegrations lists all clusters and whether the container registry is integrated with them
+func (d *DoksTool) listDOKSRegistryIntegrations(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	integrations := []registryIntegration{}
+	opt := &godo.ListOptions{Page: 1, PerPage: 200}
+	for {
+		// Make the API call
+		clusters, resp, err := d.client.Kubernetes.List(ctx, opt)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		for _, cluster := range clusters {
+			integrations = append(integrations, registryIntegration{
+				ClusterID:       cluster.ID,
+				Name:            cluster.Name,
+				Region:          cluster.RegionSlug,
+				RegistryEnabled: cluster.RegistryEnabled,
+			})
+		}
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		opt.Page = page + 1
+	}
+
+	// Marshal the response
+	integrationsJSON, err := json.MarshalIndent(integrations, "", "  ")
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("marshal error", err), nil
+	}
+
+	return mcp.NewToolResultText(string(integrationsJSON)), nil
+}
+
+// clusterIDsFromArgs extracts the ClusterIDs argument as a list of strings
+func clusterIDsFromArgs(args map[string]any) []string {
+	var clusterIDs []string
+	if idList, ok := args["ClusterIDs"].([]any); ok {
+		for _, id := range idList {
+			if idStr, ok := id.(string); ok && idStr != "" {
+				clusterIDs = append(clusterIDs, idStr)
+			}
+		}
+	}
+	return clusterIDs
+}
+
 // getDayFromString converts a day string to the format expected by the API
 func getDayFromString(day string) int {
 	// Normalize the day string
@@ -834,6 +941,26 @@ func (d *DoksTool) Tools() []server.ServerTool {
 				mcp.WithArray("NodeIDs", mcp.Required(), mcp.Description("List of node IDs to recycle")),
 			),
 		},
+		{
+			Handler: d.addDOKSRegistry,
+			Tool: mcp.NewTool("doks-add-registry",
+				mcp.WithDescription("Integrate the DigitalOcean Container Registry with Kubernetes clusters so they can pull private images"),
+				mcp.WithArray("ClusterIDs", mcp.Required(), mcp.Description("List of Kubernetes cluster IDs"), mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+		{
+			Handler: d.removeDOKSRegistry,
+			Tool: mcp.NewTool("doks-remove-registry",
+				mcp.WithDescription("Remove the DigitalOcean Container Registry integration from Kubernetes clusters"),
+				mcp.WithArray("ClusterIDs", mcp.Required(), mcp.Description("List of Kubernetes cluster IDs"), mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+		{
+			Handler: d.listDOKSRegistryIntegrations,
+			Tool: mcp.NewTool("doks-list-registry-integrations",
+				mcp.WithDescription("List all DigitalOcean Kubernetes clusters and whether the container registry is integrated with them"),
+			),
+		},
 	}
 }
 
@@ -92,6 +92,12 @@ func main() {
 		clusterSchema.Properties.Set("cluster_autoscaler_configuration", autoscalerProperty)
 	}
 
+	// Add project_id, which is not part of the godo request. The cluster is assigned to the project once it's created.
+	clusterSchema.Properties.Set("project_id", &jsonschema.Schema{
+		Type:        "string",
+		Description: "Optional. The ID of the project to assign the cluster to. Defaults to the default project.",
+	})
+
 	// Re-marshal the modified cluster schema
 	modifiedClusterSchema, err := clusterSchema.MarshalJSON()
 	if err != nil {
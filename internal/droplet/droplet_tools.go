@@ -31,6 +31,7 @@ func (d *DropletTool) createDroplet(ctx context.Context, req mcp.CallToolRequest
 	region := args["Region"].(string)
 	backup, _ := args["Backup"].(bool)         // Defaults to false
 	monitoring, _ := args["Monitoring"].(bool) // Defaults to false
+	projectID, _ := args["ProjectID"].(string) // Defaults to the default project
 	// Create the droplet
 	dropletCreateRequest := &godo.DropletCreateRequest{
 		Name:       dropletName,
@@ -44,6 +45,11 @@ func (d *DropletTool) createDroplet(ctx context.Context, req mcp.CallToolRequest
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("droplet create", err), nil
 	}
+	if projectID != "" {
+		if _, _, err := d.client.Projects.AssignResources(ctx, projectID, droplet.URN()); err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("droplet %d created but project assignment failed", droplet.ID), err), nil
+		}
+	}
 	jsonDroplet, err := json.MarshalIndent(droplet, "", "  ")
 	if err != nil {
 		return mcp.NewToolResultErrorFromErr("json marshal", err), nil
@@ -220,6 +226,7 @@ func (d *DropletTool) Tools() []server.ServerTool {
 				mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the region (e.g., nyc3)")),
 				mcp.WithBoolean("Backup", mcp.DefaultBool(false), mcp.Description("Whether to enable backups")),
 				mcp.WithBoolean("Monitoring", mcp.DefaultBool(false), mcp.Description("Whether to enable monitoring")),
+				mcp.WithString("ProjectID", mcp.Description("ID of the project to assign the droplet to (defaults to the default project)")),
 			),
 		},
 		{
@@ -106,6 +106,49 @@ func TestDropletTool_createDroplet(t *testing.T) {
 	}
 }
 
+func TestDropletTool_createDropletInProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	args := map[string]any{
+		"Name":      "web-1",
+		"Size":      "s-1vcpu-1gb",
+		"ImageID":   float64(456),
+		"Region":    "nyc1",
+		"ProjectID": "project-1",
+	}
+
+	tests := []struct {
+		name        string
+		assignErr   error
+		expectError bool
+	}{
+		{name: "Assigned to project"},
+		{name: "Project assignment fails", assignErr: errors.New("api error"), expectError: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockProjects := NewMockProjectsService(ctrl)
+			mockDroplets.EXPECT().
+				Create(gomock.Any(), gomock.Any()).
+				Return(&godo.Droplet{ID: 123, Name: "web-1"}, nil, nil).
+				Times(1)
+			mockProjects.EXPECT().
+				AssignResources(gomock.Any(), "project-1", "do:droplet:123").
+				Return(nil, nil, tc.assignErr).
+				Times(1)
+			tool := setupDropletToolWithMocks(mockDroplets, nil)
+			tool.client.Projects = mockProjects
+
+			resp, err := tool.createDroplet(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.Equal(t, tc.expectError, resp.IsError)
+		})
+	}
+}
+
 func TestDropletTool_getDropletByID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -84,6 +84,231 @@ func (i *ImagesTool) getImageByID(ctx context.Context, req mcp.CallToolRequest)
 	return mcp.NewToolResultText(string(jsonData)), nil
 }
 
+// listUserImages lists the private images of the user (snapshots, backups and custom images).
+func (i *ImagesTool) listUserImages(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	page, ok := req.GetArguments()["Page"].(float64)
+	if !ok {
+		page = defaultImagesPage
+	}
+	perPage, ok := req.GetArguments()["PerPage"].(float64)
+	if !ok {
+		perPage = defaultImagesPageSize
+	}
+
+	opt := &godo.ListOptions{
+		Page:    int(page),
+		PerPage: int(perPage),
+	}
+
+	images, _, err := i.client.Images.ListUser(ctx, opt)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	filteredImages := make([]map[string]any, len(images))
+	for idx, image := range images {
+		filteredImages[idx] = map[string]any{
+			"id":             image.ID,
+			"name":           image.Name,
+			"distribution":   image.Distribution,
+			"type":           image.Type,
+			"regions":        image.Regions,
+			"size_gigabytes": image.SizeGigaBytes,
+			"status":         image.Status,
+			"tags":           image.Tags,
+			"created_at":     image.Created,
+		}
+	}
+
+	jsonData, err := json.MarshalIndent(filteredImages, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// createCustomImage imports a custom image from a publicly accessible URL.
+func (i *ImagesTool) createCustomImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Name is required"), nil
+	}
+	url, ok := args["Url"].(string)
+	if !ok || url == "" {
+		return mcp.NewToolResultError("Url is required"), nil
+	}
+	region, ok := args["Region"].(string)
+	if !ok || region == "" {
+		return mcp.NewToolResultError("Region is required"), nil
+	}
+	distribution, _ := args["Distribution"].(string)
+	description, _ := args["Description"].(string)
+
+	var tags []string
+	if rawTags, ok := args["Tags"].([]any); ok {
+		for _, v := range rawTags {
+			if tag, ok := v.(string); ok {
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	createRequest := &godo.CustomImageCreateRequest{
+		Name:         name,
+		Url:          url,
+		Region:       region,
+		Distribution: distribution,
+		Description:  description,
+		Tags:         tags,
+	}
+
+	image, _, err := i.client.Images.Create(ctx, createRequest)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(image, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// updateImage updates the name, distribution or description of an image.
+func (i *ImagesTool) updateImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["ID"].(float64)
+	if !ok {
+		return mcp.NewToolResultError("Image ID is required"), nil
+	}
+	name, _ := args["Name"].(string)
+	distribution, _ := args["Distribution"].(string)
+	description, _ := args["Description"].(string)
+	if name == "" && distribution == "" && description == "" {
+		return mcp.NewToolResultError("At least one of Name, Distribution or Description is required"), nil
+	}
+
+	updateRequest := &godo.ImageUpdateRequest{
+		Name:         name,
+		Distribution: distribution,
+		Description:  description,
+	}
+
+	image, _, err := i.client.Images.Update(ctx, int(id), updateRequest)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(image, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// deleteImage deletes a snapshot, backup or custom image.
+func (i *ImagesTool) deleteImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(float64)
+	if !ok {
+		return mcp.NewToolResultError("Image ID is required"), nil
+	}
+
+	_, err := i.client.Images.Delete(ctx, int(id))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	return mcp.NewToolResultText("Image deleted successfully"), nil
+}
+
+// transferImage transfers an image to one or more additional regions. A transfer action is started per region.
+func (i *ImagesTool) transferImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["ID"].(float64)
+	if !ok {
+		return mcp.NewToolResultError("Image ID is required"), nil
+	}
+
+	var regions []string
+	if rawRegions, ok := args["Regions"].([]any); ok {
+		for _, v := range rawRegions {
+			if region, ok := v.(string); ok && region != "" {
+				regions = append(regions, region)
+			}
+		}
+	}
+	if len(regions) == 0 {
+		return mcp.NewToolResultError("At least one region is required"), nil
+	}
+
+	actions := make([]*godo.Action, 0, len(regions))
+	for _, region := range regions {
+		action, _, err := i.client.ImageActions.Transfer(ctx, int(id), &godo.ActionRequest{
+			"type":   "transfer",
+			"region": region,
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr(fmt.Sprintf("api error transferring to %s", region), err), nil
+		}
+		actions = append(actions, action)
+	}
+
+	jsonData, err := json.MarshalIndent(actions, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// convertImage converts a backup image into a snapshot.
+func (i *ImagesTool) convertImage(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(float64)
+	if !ok {
+		return mcp.NewToolResultError("Image ID is required"), nil
+	}
+
+	action, _, err := i.client.ImageActions.Convert(ctx, int(id))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(action, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// getImageAction retrieves the status of an image action such as a transfer or conversion.
+func (i *ImagesTool) getImageAction(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	imageID, ok := req.GetArguments()["ImageID"].(float64)
+	if !ok {
+		return mcp.NewToolResultError("ImageID is required"), nil
+	}
+	actionID, ok := req.GetArguments()["ActionID"].(float64)
+	if !ok {
+		return mcp.NewToolResultError("ActionID is required"), nil
+	}
+
+	action, _, err := i.client.ImageActions.Get(ctx, int(imageID), int(actionID))
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	jsonData, err := json.MarshalIndent(action, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
 // Tools returns the list of server tools for images.
 func (i *ImagesTool) Tools() []server.ServerTool {
 	return []server.ServerTool{
@@ -104,5 +329,72 @@ func (i *ImagesTool) Tools() []server.ServerTool {
 				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Image ID")),
 			),
 		},
+		{
+			Handler: i.listUserImages,
+			Tool: mcp.NewTool(
+				"image-list-user",
+				mcp.WithDescription("List private images of the user, including snapshots, backups and custom images. Supports pagination."),
+				mcp.WithNumber("Page", mcp.DefaultNumber(defaultImagesPage), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultImagesPageSize), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: i.createCustomImage,
+			Tool: mcp.NewTool(
+				"image-create-custom",
+				mcp.WithDescription("Import a custom image from a publicly accessible URL (raw, qcow2, vhdx, vdi or vmdk, optionally gzip or bzip2 compressed)."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the custom image")),
+				mcp.WithString("Url", mcp.Required(), mcp.Description("Publicly accessible URL of the image file")),
+				mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the region the image is imported into (e.g., nyc3)")),
+				mcp.WithString("Distribution", mcp.Description("Distribution of the image (e.g., Ubuntu, Debian, Unknown)")),
+				mcp.WithString("Description", mcp.Description("Free-form description of the image")),
+				mcp.WithArray("Tags", mcp.Description("Tags to apply to the image"), mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+		{
+			Handler: i.updateImage,
+			Tool: mcp.NewTool(
+				"image-update",
+				mcp.WithDescription("Update the name, distribution or description of an image."),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Image ID")),
+				mcp.WithString("Name", mcp.Description("New name of the image")),
+				mcp.WithString("Distribution", mcp.Description("New distribution of the image")),
+				mcp.WithString("Description", mcp.Description("New description of the image")),
+			),
+		},
+		{
+			Handler: i.deleteImage,
+			Tool: mcp.NewTool(
+				"image-delete",
+				mcp.WithDescription("Delete a snapshot, backup or custom image."),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Image ID")),
+			),
+		},
+		{
+			Handler: i.transferImage,
+			Tool: mcp.NewTool(
+				"image-transfer",
+				mcp.WithDescription("Transfer an image to one or more additional regions. One transfer action is started per region."),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Image ID")),
+				mcp.WithArray("Regions", mcp.Required(), mcp.Description("Slugs of the regions to transfer the image to (e.g., ams3, sfo3)"), mcp.Items(map[string]any{"type": "string"})),
+			),
+		},
+		{
+			Handler: i.convertImage,
+			Tool: mcp.NewTool(
+				"image-convert-to-snapshot",
+				mcp.WithDescription("Convert a backup image into a snapshot."),
+				mcp.WithNumber("ID", mcp.Required(), mcp.Description("Image ID of the backup")),
+			),
+		},
+		{
+			Handler: i.getImageAction,
+			Tool: mcp.NewTool(
+				"image-action-get",
+				mcp.WithDescription("Get the status of an image action, such as a transfer or conversion."),
+				mcp.WithNumber("ImageID", mcp.Required(), mcp.Description("Image ID")),
+				mcp.WithNumber("ActionID", mcp.Required(), mcp.Description("Action ID")),
+			),
+		},
 	}
 }
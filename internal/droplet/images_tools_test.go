@@ -18,6 +18,12 @@ func setupImagesToolWithMock(images *MockImagesService) *ImagesTool {
 	return NewImagesTool(client)
 }
 
+func setupImageActionsToolWithMock(imageActions *MockImageActionsService) *ImagesTool {
+	client := &godo.Client{}
+	client.ImageActions = imageActions
+	return NewImagesTool(client)
+}
+
 func TestImagesTool_listImages(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -169,3 +175,258 @@ func TestImagesTool_getImageByID(t *testing.T) {
 		})
 	}
 }
+
+func TestImagesTool_listUserImages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testImages := []godo.Image{
+		{ID: 10, Name: "golden-web-2024", Type: "custom", Regions: []string{"nyc3"}},
+		{ID: 11, Name: "web-1 snapshot", Type: "snapshot", Regions: []string{"ams3"}},
+	}
+	tests := []struct {
+		name        string
+		mockSetup   func(*MockImagesService)
+		expectError bool
+	}{
+		{
+			name: "Successful list",
+			mockSetup: func(m *MockImagesService) {
+				m.EXPECT().
+					ListUser(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: 50}).
+					Return(testImages, &godo.Response{}, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			mockSetup: func(m *MockImagesService) {
+				m.EXPECT().
+					ListUser(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: 50}).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockImages := NewMockImagesService(ctrl)
+			tc.mockSetup(mockImages)
+			tool := setupImagesToolWithMock(mockImages)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}}
+			resp, err := tool.listUserImages(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+			content := resp.Content[0].(mcp.TextContent).Text
+			var outImages []map[string]any
+			require.NoError(t, json.Unmarshal([]byte(content), &outImages))
+			require.Len(t, outImages, len(testImages))
+			require.Equal(t, "custom", outImages[0]["type"])
+		})
+	}
+}
+
+func TestImagesTool_createCustomImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockImagesService)
+		expectError bool
+	}{
+		{
+			name: "Successful import",
+			args: map[string]any{
+				"Name":         "golden-web",
+				"Url":          "https://example.com/golden-web.qcow2",
+				"Region":       "nyc3",
+				"Distribution": "Ubuntu",
+				"Tags":         []any{"packer", "web"},
+			},
+			mockSetup: func(m *MockImagesService) {
+				m.EXPECT().
+					Create(gomock.Any(), &godo.CustomImageCreateRequest{
+						Name:         "golden-web",
+						Url:          "https://example.com/golden-web.qcow2",
+						Region:       "nyc3",
+						Distribution: "Ubuntu",
+						Tags:         []string{"packer", "web"},
+					}).
+					Return(&godo.Image{ID: 77, Name: "golden-web", Status: "NEW"}, &godo.Response{}, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{
+				"Name":   "golden-web",
+				"Url":    "https://example.com/golden-web.qcow2",
+				"Region": "nyc3",
+			},
+			mockSetup: func(m *MockImagesService) {
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing Url",
+			args:        map[string]any{"Name": "golden-web", "Region": "nyc3"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockImages := NewMockImagesService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockImages)
+			}
+			tool := setupImagesToolWithMock(mockImages)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createCustomImage(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+			var outImage godo.Image
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &outImage))
+			require.Equal(t, 77, outImage.ID)
+		})
+	}
+}
+
+func TestImagesTool_updateAndDeleteImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImages := NewMockImagesService(ctrl)
+	mockImages.EXPECT().
+		Update(gomock.Any(), 77, &godo.ImageUpdateRequest{Name: "golden-web-v2"}).
+		Return(&godo.Image{ID: 77, Name: "golden-web-v2"}, &godo.Response{}, nil).
+		Times(1)
+	mockImages.EXPECT().
+		Delete(gomock.Any(), 77).
+		Return(&godo.Response{}, nil).
+		Times(1)
+	tool := setupImagesToolWithMock(mockImages)
+
+	resp, err := tool.updateImage(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": float64(77), "Name": "golden-web-v2"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "golden-web-v2")
+
+	resp, err = tool.updateImage(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": float64(77)}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+
+	resp, err = tool.deleteImage(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": float64(77)}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, "Image deleted successfully", resp.Content[0].(mcp.TextContent).Text)
+}
+
+func TestImagesTool_transferImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockImageActionsService)
+		expectError bool
+		expectCount int
+	}{
+		{
+			name: "Transfer to two regions",
+			args: map[string]any{"ID": float64(77), "Regions": []any{"ams3", "sfo3"}},
+			mockSetup: func(m *MockImageActionsService) {
+				m.EXPECT().
+					Transfer(gomock.Any(), 77, &godo.ActionRequest{"type": "transfer", "region": "ams3"}).
+					Return(&godo.Action{ID: 1, Type: "transfer", Status: "in-progress"}, &godo.Response{}, nil).
+					Times(1)
+				m.EXPECT().
+					Transfer(gomock.Any(), 77, &godo.ActionRequest{"type": "transfer", "region": "sfo3"}).
+					Return(&godo.Action{ID: 2, Type: "transfer", Status: "in-progress"}, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectCount: 2,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"ID": float64(77), "Regions": []any{"ams3"}},
+			mockSetup: func(m *MockImageActionsService) {
+				m.EXPECT().
+					Transfer(gomock.Any(), 77, gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing regions",
+			args:        map[string]any{"ID": float64(77)},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockImageActions := NewMockImageActionsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockImageActions)
+			}
+			tool := setupImageActionsToolWithMock(mockImageActions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.transferImage(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			var actions []godo.Action
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &actions))
+			require.Len(t, actions, tc.expectCount)
+		})
+	}
+}
+
+func TestImagesTool_convertImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageActions := NewMockImageActionsService(ctrl)
+	mockImageActions.EXPECT().
+		Convert(gomock.Any(), 88).
+		Return(&godo.Action{ID: 3, Type: "convert"}, &godo.Response{}, nil).
+		Times(1)
+	mockImageActions.EXPECT().
+		Get(gomock.Any(), 88, 3).
+		Return(&godo.Action{ID: 3, Type: "convert", Status: "completed"}, &godo.Response{}, nil).
+		Times(1)
+	tool := setupImageActionsToolWithMock(mockImageActions)
+
+	resp, err := tool.convertImage(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": float64(88)}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.getImageAction(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ImageID": float64(88), "ActionID": float64(3)}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "completed")
+}
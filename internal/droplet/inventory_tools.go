@@ -0,0 +1,497 @@
+package droplet
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	inventoryPageSize = 200
+
+	inventoryFormatCSV      = "csv"
+	inventoryFormatJSONL    = "jsonl"
+	inventoryFormatMarkdown = "markdown"
+
+	hostsFormatAnsible   = "ansible"
+	hostsFormatSSHConfig = "ssh-config"
+
+	addressPublic  = "public"
+	addressPrivate = "private"
+
+	defaultHostsUser = "root"
+)
+
+// ansibleGroupNameReplacer matches the characters that are not valid in Ansible group names.
+var ansibleGroupNameReplacer = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// inventoryColumns is the ordered list of columns emitted for each droplet in an inventory export.
+var inventoryColumns = []string{
+	"id", "name", "status", "region", "size", "vcpus", "memory_mb", "disk_gb", "price_monthly",
+	"public_ipv4", "private_ipv4", "public_ipv6", "vpc_uuid", "tags", "volume_ids", "firewalls",
+}
+
+// InventoryTool provides tools for exporting droplet inventories.
+type InventoryTool struct {
+	client *godo.Client
+}
+
+// NewInventoryTool creates a new InventoryTool instance.
+func NewInventoryTool(client *godo.Client) *InventoryTool {
+	return &InventoryTool{client: client}
+}
+
+// inventoryRow is a flattened view of a droplet used in inventory exports.
+type inventoryRow struct {
+	ID           int      `json:"id"`
+	Name         string   `json:"name"`
+	Status       string   `json:"status"`
+	Region       string   `json:"region"`
+	Size         string   `json:"size"`
+	Vcpus        int      `json:"vcpus"`
+	MemoryMB     int      `json:"memory_mb"`
+	DiskGB       int      `json:"disk_gb"`
+	PriceMonthly float64  `json:"price_monthly"`
+	PublicIPv4   string   `json:"public_ipv4"`
+	PrivateIPv4  string   `json:"private_ipv4"`
+	PublicIPv6   string   `json:"public_ipv6"`
+	VPCUUID      string   `json:"vpc_uuid"`
+	Tags         []string `json:"tags"`
+	VolumeIDs    []string `json:"volume_ids"`
+	Firewalls    []string `json:"firewalls"`
+}
+
+// values returns the row as strings, in the order of inventoryColumns.
+func (r inventoryRow) values() []string {
+	return []string{
+		strconv.Itoa(r.ID),
+		r.Name,
+		r.Status,
+		r.Region,
+		r.Size,
+		strconv.Itoa(r.Vcpus),
+		strconv.Itoa(r.MemoryMB),
+		strconv.Itoa(r.DiskGB),
+		strconv.FormatFloat(r.PriceMonthly, 'f', 2, 64),
+		r.PublicIPv4,
+		r.PrivateIPv4,
+		r.PublicIPv6,
+		r.VPCUUID,
+		strings.Join(r.Tags, ";"),
+		strings.Join(r.VolumeIDs, ";"),
+		strings.Join(r.Firewalls, ";"),
+	}
+}
+
+// exportInventory exports droplets, optionally filtered by tag or name, as CSV, JSON Lines or a Markdown table.
+func (i *InventoryTool) exportInventory(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	tag, _ := args["Tag"].(string)
+	name, _ := args["Name"].(string)
+	if tag != "" && name != "" {
+		return mcp.NewToolResultError("Only one of Tag or Name can be provided"), nil
+	}
+
+	format := inventoryFormatCSV
+	if v, ok := args["Format"].(string); ok && v != "" {
+		format = strings.ToLower(v)
+	}
+	if !slices.Contains([]string{inventoryFormatCSV, inventoryFormatJSONL, inventoryFormatMarkdown}, format) {
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported format: %s, supported formats are: csv, jsonl, markdown", format)), nil
+	}
+
+	droplets, err := i.listAllDroplets(ctx, tag, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	firewalls, err := i.listAllFirewalls(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	rows := make([]inventoryRow, len(droplets))
+	for idx := range droplets {
+		rows[idx] = newInventoryRow(&droplets[idx], firewalls)
+	}
+
+	var out string
+	switch format {
+	case inventoryFormatCSV:
+		out, err = inventoryToCSV(rows)
+	case inventoryFormatJSONL:
+		out, err = inventoryToJSONL(rows)
+	case inventoryFormatMarkdown:
+		out = inventoryToMarkdown(rows)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("format error: %w", err)
+	}
+
+	return mcp.NewToolResultText(out), nil
+}
+
+// listAllDroplets walks all pages of droplets. A non-empty tag or name narrows the listing with ListByTag or ListByName.
+func (i *InventoryTool) listAllDroplets(ctx context.Context, tag, name string) ([]godo.Droplet, error) {
+	var all []godo.Droplet
+	opt := &godo.ListOptions{Page: 1, PerPage: inventoryPageSize}
+	for {
+		var (
+			droplets []godo.Droplet
+			resp     *godo.Response
+			err      error
+		)
+		switch {
+		case tag != "":
+			droplets, resp, err = i.client.Droplets.ListByTag(ctx, tag, opt)
+		case name != "":
+			droplets, resp, err = i.client.Droplets.ListByName(ctx, name, opt)
+		default:
+			droplets, resp, err = i.client.Droplets.List(ctx, opt)
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, droplets...)
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			return all, nil
+		}
+		opt.Page++
+	}
+}
+
+// listAllFirewalls walks all pages of firewalls.
+func (i *InventoryTool) listAllFirewalls(ctx context.Context) ([]godo.Firewall, error) {
+	var all []godo.Firewall
+	opt := &godo.ListOptions{Page: 1, PerPage: inventoryPageSize}
+	for {
+		firewalls, resp, err := i.client.Firewalls.List(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, firewalls...)
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			return all, nil
+		}
+		opt.Page++
+	}
+}
+
+// newInventoryRow flattens a droplet into an inventory row. A droplet is a member of a firewall
+// when the firewall targets its ID directly or one of its tags.
+func newInventoryRow(droplet *godo.Droplet, firewalls []godo.Firewall) inventoryRow {
+	row := inventoryRow{
+		ID:        droplet.ID,
+		Name:      droplet.Name,
+		Status:    droplet.Status,
+		Size:      droplet.SizeSlug,
+		Vcpus:     droplet.Vcpus,
+		MemoryMB:  droplet.Memory,
+		DiskGB:    droplet.Disk,
+		VPCUUID:   droplet.VPCUUID,
+		Tags:      droplet.Tags,
+		VolumeIDs: droplet.VolumeIDs,
+		Firewalls: []string{},
+	}
+	if droplet.Region != nil {
+		row.Region = droplet.Region.Slug
+	}
+	if droplet.Size != nil {
+		row.PriceMonthly = droplet.Size.PriceMonthly
+	}
+	if droplet.Networks != nil {
+		row.PublicIPv4, _ = droplet.PublicIPv4()
+		row.PrivateIPv4, _ = droplet.PrivateIPv4()
+		row.PublicIPv6, _ = droplet.PublicIPv6()
+	}
+	if row.Tags == nil {
+		row.Tags = []string{}
+	}
+	if row.VolumeIDs == nil {
+		row.VolumeIDs = []string{}
+	}
+
+	for _, firewall := range firewalls {
+		if slices.Contains(firewall.DropletIDs, droplet.ID) || slices.ContainsFunc(firewall.Tags, func(t string) bool { return slices.Contains(droplet.Tags, t) }) {
+			row.Firewalls = append(row.Firewalls, firewall.Name)
+		}
+	}
+
+	return row
+}
+
+// inventoryToCSV renders inventory rows as CSV with a header line.
+func inventoryToCSV(rows []inventoryRow) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(inventoryColumns); err != nil {
+		return "", err
+	}
+	for _, row := range rows {
+		if err := w.Write(row.values()); err != nil {
+			return "", err
+		}
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
+
+// inventoryToJSONL renders inventory rows as JSON Lines, one droplet per line.
+func inventoryToJSONL(rows []inventoryRow) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+// inventoryToMarkdown renders inventory rows as a Markdown table.
+func inventoryToMarkdown(rows []inventoryRow) string {
+	var sb strings.Builder
+	sb.WriteString("| " + strings.Join(inventoryColumns, " | ") + " |\n")
+	sb.WriteString("|" + strings.Repeat(" --- |", len(inventoryColumns)) + "\n")
+	for _, row := range rows {
+		values := row.values()
+		for idx, v := range values {
+			values[idx] = strings.ReplaceAll(v, "|", `\|`)
+		}
+		sb.WriteString("| " + strings.Join(values, " | ") + " |\n")
+	}
+	return sb.String()
+}
+
+// exportHosts renders droplets as an Ansible YAML inventory or as an ~/.ssh/config fragment.
+func (i *InventoryTool) exportHosts(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	tag, _ := args["Tag"].(string)
+	name, _ := args["Name"].(string)
+	if tag != "" && name != "" {
+		return mcp.NewToolResultError("Only one of Tag or Name can be provided"), nil
+	}
+
+	format := hostsFormatAnsible
+	if v, ok := args["Format"].(string); ok && v != "" {
+		format = strings.ToLower(v)
+	}
+	if format != hostsFormatAnsible && format != hostsFormatSSHConfig {
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported format: %s, supported formats are: ansible, ssh-config", format)), nil
+	}
+
+	address := addressPublic
+	if v, ok := args["Address"].(string); ok && v != "" {
+		address = strings.ToLower(v)
+	}
+	if address != addressPublic && address != addressPrivate {
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported address: %s, supported addresses are: public, private", address)), nil
+	}
+
+	groupBy := []string{"tag", "region", "vpc"}
+	if rawGroupBy, ok := args["GroupBy"].([]any); ok {
+		groupBy = groupBy[:0]
+		for _, v := range rawGroupBy {
+			group, ok := v.(string)
+			if !ok || !slices.Contains([]string{"tag", "region", "vpc"}, group) {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported group: %v, supported groups are: tag, region, vpc", v)), nil
+			}
+			groupBy = append(groupBy, group)
+		}
+	}
+
+	user := defaultHostsUser
+	if v, ok := args["User"].(string); ok && v != "" {
+		user = v
+	}
+	identityFile, _ := args["IdentityFile"].(string)
+
+	droplets, err := i.listAllDroplets(ctx, tag, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	var out string
+	switch format {
+	case hostsFormatAnsible:
+		out, err = dropletsToAnsibleInventory(droplets, address, groupBy, user)
+		if err != nil {
+			return nil, fmt.Errorf("marshal error: %w", err)
+		}
+	case hostsFormatSSHConfig:
+		out = dropletsToSSHConfig(droplets, address, user, identityFile)
+	}
+
+	return mcp.NewToolResultText(out), nil
+}
+
+// dropletAddress returns the public or private IPv4 address of a droplet, or an empty string if it has none.
+func dropletAddress(droplet *godo.Droplet, address string) string {
+	if droplet.Networks == nil {
+		return ""
+	}
+	var ip string
+	if address == addressPrivate {
+		ip, _ = droplet.PrivateIPv4()
+	} else {
+		ip, _ = droplet.PublicIPv4()
+	}
+	return ip
+}
+
+// ansibleGroupName builds a valid Ansible group name from a prefix and a value.
+func ansibleGroupName(prefix, value string) string {
+	return prefix + "_" + ansibleGroupNameReplacer.ReplaceAllString(value, "_")
+}
+
+// dropletHostNames returns the inventory host name of each droplet. Droplet names are not unique, so droplets
+// sharing a name are disambiguated with their ID (e.g., web-123456) instead of shadowing each other.
+func dropletHostNames(droplets []godo.Droplet) []string {
+	counts := make(map[string]int, len(droplets))
+	for idx := range droplets {
+		counts[droplets[idx].Name]++
+	}
+	names := make([]string, len(droplets))
+	for idx := range droplets {
+		names[idx] = droplets[idx].Name
+		if counts[names[idx]] > 1 {
+			names[idx] = fmt.Sprintf("%s-%d", droplets[idx].Name, droplets[idx].ID)
+		}
+	}
+	return names
+}
+
+// dropletsToAnsibleInventory renders droplets as an Ansible YAML inventory. Droplets without an address of the
+// requested kind are left out and listed in a comment at the top of the inventory.
+func dropletsToAnsibleInventory(droplets []godo.Droplet, address string, groupBy []string, user string) (string, error) {
+	hosts := make(map[string]any)
+	children := make(map[string]map[string]map[string]any)
+	var skipped []string
+	hostNames := dropletHostNames(droplets)
+
+	addToGroup := func(group, host string) {
+		if _, ok := children[group]; !ok {
+			children[group] = map[string]map[string]any{"hosts": {}}
+		}
+		children[group]["hosts"][host] = nil
+	}
+
+	for idx := range droplets {
+		droplet := &droplets[idx]
+		ip := dropletAddress(droplet, address)
+		if ip == "" {
+			skipped = append(skipped, hostNames[idx])
+			continue
+		}
+
+		hostVars := map[string]any{
+			"ansible_host": ip,
+			"ansible_user": user,
+			"do_id":        droplet.ID,
+		}
+		if droplet.Region != nil {
+			hostVars["do_region"] = droplet.Region.Slug
+		}
+		if droplet.SizeSlug != "" {
+			hostVars["do_size"] = droplet.SizeSlug
+		}
+		if len(droplet.Tags) > 0 {
+			hostVars["do_tags"] = droplet.Tags
+		}
+		hosts[hostNames[idx]] = hostVars
+
+		for _, group := range groupBy {
+			switch group {
+			case "tag":
+				for _, t := range droplet.Tags {
+					addToGroup(ansibleGroupName("tag", t), hostNames[idx])
+				}
+			case "region":
+				if droplet.Region != nil {
+					addToGroup(ansibleGroupName("region", droplet.Region.Slug), hostNames[idx])
+				}
+			case "vpc":
+				if droplet.VPCUUID != "" {
+					addToGroup(ansibleGroupName("vpc", droplet.VPCUUID), hostNames[idx])
+				}
+			}
+		}
+	}
+
+	all := map[string]any{"hosts": hosts}
+	if len(children) > 0 {
+		all["children"] = children
+	}
+	data, err := yaml.Marshal(map[string]any{"all": all})
+	if err != nil {
+		return "", err
+	}
+
+	if len(skipped) == 0 {
+		return string(data), nil
+	}
+	return fmt.Sprintf("# skipped droplets without a %s IPv4 address: %s\n%s", address, strings.Join(skipped, ", "), data), nil
+}
+
+// dropletsToSSHConfig renders droplets as an ~/.ssh/config fragment with one Host entry per droplet.
+func dropletsToSSHConfig(droplets []godo.Droplet, address, user, identityFile string) string {
+	var sb strings.Builder
+	hostNames := dropletHostNames(droplets)
+	for idx := range droplets {
+		droplet := &droplets[idx]
+		ip := dropletAddress(droplet, address)
+		if ip == "" {
+			fmt.Fprintf(&sb, "# %s skipped: no %s IPv4 address\n\n", hostNames[idx], address)
+			continue
+		}
+		fmt.Fprintf(&sb, "Host %s\n", hostNames[idx])
+		fmt.Fprintf(&sb, "  HostName %s\n", ip)
+		fmt.Fprintf(&sb, "  User %s\n", user)
+		if identityFile != "" {
+			fmt.Fprintf(&sb, "  IdentityFile %s\n", identityFile)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Tools returns the list of server tools for droplet inventories.
+func (i *InventoryTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: i.exportInventory,
+			Tool: mcp.NewTool(
+				"droplet-inventory-export",
+				mcp.WithDescription("Export an inventory of all droplets, walking every page. Includes IPs, tags, VPC, size, monthly cost, volumes and firewall membership. Optionally filter by tag or by name."),
+				mcp.WithString("Format", mcp.DefaultString(inventoryFormatCSV), mcp.Description("Output format: 'csv', 'jsonl' (JSON Lines) or 'markdown' (table)")),
+				mcp.WithString("Tag", mcp.Description("Only include droplets with this tag")),
+				mcp.WithString("Name", mcp.Description("Only include droplets with this exact name")),
+			),
+		},
+		{
+			Handler: i.exportHosts,
+			Tool: mcp.NewTool(
+				"droplet-inventory-hosts",
+				mcp.WithDescription("Generate an Ansible YAML inventory or an ~/.ssh/config fragment from droplets. Ansible hosts are grouped by tag, region and VPC. Optionally filter by tag or by name."),
+				mcp.WithString("Format", mcp.DefaultString(hostsFormatAnsible), mcp.Description("Output format: 'ansible' (YAML inventory) or 'ssh-config'")),
+				mcp.WithString("Address", mcp.DefaultString(addressPublic), mcp.Description("Which IPv4 address to connect to: 'public' or 'private'")),
+				mcp.WithArray("GroupBy", mcp.Description("Ansible groups to generate: any of 'tag', 'region', 'vpc' (default all)"), mcp.Items(map[string]any{"type": "string"})),
+				mcp.WithString("User", mcp.DefaultString(defaultHostsUser), mcp.Description("Remote user to connect as")),
+				mcp.WithString("IdentityFile", mcp.Description("Path of the private key to use in the ssh config (e.g., ~/.ssh/id_ed25519)")),
+				mcp.WithString("Tag", mcp.Description("Only include droplets with this tag")),
+				mcp.WithString("Name", mcp.Description("Only include droplets with this exact name")),
+			),
+		},
+	}
+}
@@ -0,0 +1,303 @@
+package droplet
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/yaml.v3"
+)
+
+func setupInventoryToolWithMocks(droplets *MockDropletsService, firewalls *MockFirewallsService) *InventoryTool {
+	client := &godo.Client{}
+	client.Droplets = droplets
+	client.Firewalls = firewalls
+	return NewInventoryTool(client)
+}
+
+func testInventoryDroplets() []godo.Droplet {
+	return []godo.Droplet{
+		{
+			ID:       1,
+			Name:     "web-1",
+			Status:   "active",
+			SizeSlug: "s-1vcpu-1gb",
+			Vcpus:    1,
+			Memory:   1024,
+			Disk:     25,
+			Region:   &godo.Region{Slug: "nyc3"},
+			Size:     &godo.Size{Slug: "s-1vcpu-1gb", PriceMonthly: 6},
+			Networks: &godo.Networks{
+				V4: []godo.NetworkV4{
+					{IPAddress: "203.0.113.10", Type: "public"},
+					{IPAddress: "10.10.0.2", Type: "private"},
+				},
+			},
+			Tags:      []string{"web", "prod"},
+			VolumeIDs: []string{"vol-1"},
+			VPCUUID:   "vpc-1",
+		},
+		{
+			ID:     2,
+			Name:   "db-1",
+			Status: "off",
+			Region: &godo.Region{Slug: "ams3"},
+			Tags:   []string{"db"},
+		},
+	}
+}
+
+func TestInventoryTool_exportInventory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testFirewalls := []godo.Firewall{
+		{ID: "fw-1", Name: "web-fw", Tags: []string{"web"}},
+		{ID: "fw-2", Name: "db-fw", DropletIDs: []int{2}},
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockDropletsService, *MockFirewallsService)
+		expectError bool
+		check       func(t *testing.T, out string)
+	}{
+		{
+			name: "CSV across pages",
+			args: map[string]any{},
+			mockSetup: func(d *MockDropletsService, f *MockFirewallsService) {
+				droplets := testInventoryDroplets()
+				d.EXPECT().
+					List(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: 200}).
+					Return(droplets[:1], &godo.Response{Links: &godo.Links{Pages: &godo.Pages{Next: "https://api.digitalocean.com/v2/droplets?page=2", Last: "https://api.digitalocean.com/v2/droplets?page=2"}}}, nil).
+					Times(1)
+				d.EXPECT().
+					List(gomock.Any(), &godo.ListOptions{Page: 2, PerPage: 200}).
+					Return(droplets[1:], &godo.Response{}, nil).
+					Times(1)
+				f.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testFirewalls, &godo.Response{}, nil).
+					Times(1)
+			},
+			check: func(t *testing.T, out string) {
+				records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, 3)
+				require.Equal(t, inventoryColumns, records[0])
+				require.Equal(t, []string{"1", "web-1", "active", "nyc3", "s-1vcpu-1gb", "1", "1024", "25", "6.00", "203.0.113.10", "10.10.0.2", "", "vpc-1", "web;prod", "vol-1", "web-fw"}, records[1])
+				require.Equal(t, "db-fw", records[2][15])
+			},
+		},
+		{
+			name: "JSON Lines by tag",
+			args: map[string]any{"Format": "jsonl", "Tag": "web"},
+			mockSetup: func(d *MockDropletsService, f *MockFirewallsService) {
+				d.EXPECT().
+					ListByTag(gomock.Any(), "web", gomock.Any()).
+					Return(testInventoryDroplets()[:1], &godo.Response{}, nil).
+					Times(1)
+				f.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testFirewalls, &godo.Response{}, nil).
+					Times(1)
+			},
+			check: func(t *testing.T, out string) {
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				require.Len(t, lines, 1)
+				var row inventoryRow
+				require.NoError(t, json.Unmarshal([]byte(lines[0]), &row))
+				require.Equal(t, "web-1", row.Name)
+				require.Equal(t, []string{"web-fw"}, row.Firewalls)
+			},
+		},
+		{
+			name: "Markdown by name",
+			args: map[string]any{"Format": "markdown", "Name": "db-1"},
+			mockSetup: func(d *MockDropletsService, f *MockFirewallsService) {
+				d.EXPECT().
+					ListByName(gomock.Any(), "db-1", gomock.Any()).
+					Return(testInventoryDroplets()[1:], &godo.Response{}, nil).
+					Times(1)
+				f.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(nil, &godo.Response{}, nil).
+					Times(1)
+			},
+			check: func(t *testing.T, out string) {
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				require.Len(t, lines, 3)
+				require.True(t, strings.HasPrefix(lines[0], "| id | name |"))
+				require.Contains(t, lines[2], "| db-1 |")
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{},
+			mockSetup: func(d *MockDropletsService, _ *MockFirewallsService) {
+				d.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported format",
+			args:        map[string]any{"Format": "xml"},
+			expectError: true,
+		},
+		{
+			name:        "Tag and name together",
+			args:        map[string]any{"Tag": "web", "Name": "web-1"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			mockFirewalls := NewMockFirewallsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockDroplets, mockFirewalls)
+			}
+			tool := setupInventoryToolWithMocks(mockDroplets, mockFirewalls)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.exportInventory(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			tc.check(t, resp.Content[0].(mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestInventoryTool_exportHosts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		expectError bool
+		check       func(t *testing.T, out string)
+	}{
+		{
+			name: "Ansible inventory with public addresses",
+			args: map[string]any{},
+			check: func(t *testing.T, out string) {
+				require.True(t, strings.HasPrefix(out, "# skipped droplets without a public IPv4 address: db-1\n"))
+				var inventory struct {
+					All struct {
+						Hosts    map[string]map[string]any `yaml:"hosts"`
+						Children map[string]struct {
+							Hosts map[string]any `yaml:"hosts"`
+						} `yaml:"children"`
+					} `yaml:"all"`
+				}
+				require.NoError(t, yaml.Unmarshal([]byte(out), &inventory))
+				require.Len(t, inventory.All.Hosts, 1)
+				require.Equal(t, "203.0.113.10", inventory.All.Hosts["web-1"]["ansible_host"])
+				require.Equal(t, "root", inventory.All.Hosts["web-1"]["ansible_user"])
+				require.Contains(t, inventory.All.Children, "tag_web")
+				require.Contains(t, inventory.All.Children, "tag_prod")
+				require.Contains(t, inventory.All.Children, "region_nyc3")
+				require.Contains(t, inventory.All.Children, "vpc_vpc_1")
+				require.Contains(t, inventory.All.Children["tag_web"].Hosts, "web-1")
+			},
+		},
+		{
+			name: "Ansible inventory grouped by region with private addresses",
+			args: map[string]any{"Address": "private", "GroupBy": []any{"region"}, "User": "deploy"},
+			check: func(t *testing.T, out string) {
+				require.Contains(t, out, "ansible_host: 10.10.0.2")
+				require.Contains(t, out, "ansible_user: deploy")
+				require.Contains(t, out, "region_nyc3:")
+				require.NotContains(t, out, "tag_web")
+			},
+		},
+		{
+			name: "SSH config",
+			args: map[string]any{"Format": "ssh-config", "IdentityFile": "~/.ssh/id_ed25519"},
+			check: func(t *testing.T, out string) {
+				require.Contains(t, out, "Host web-1\n  HostName 203.0.113.10\n  User root\n  IdentityFile ~/.ssh/id_ed25519\n")
+				require.Contains(t, out, "# db-1 skipped: no public IPv4 address")
+			},
+		},
+		{
+			name:        "Unsupported group",
+			args:        map[string]any{"GroupBy": []any{"size"}},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported address",
+			args:        map[string]any{"Address": "ipv6"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockDroplets := NewMockDropletsService(ctrl)
+			if !tc.expectError {
+				mockDroplets.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testInventoryDroplets(), &godo.Response{}, nil).
+					Times(1)
+			}
+			tool := setupInventoryToolWithMocks(mockDroplets, nil)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.exportHosts(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			tc.check(t, resp.Content[0].(mcp.TextContent).Text)
+		})
+	}
+}
+
+func TestInventoryTool_exportHostsDuplicateNames(t *testing.T) {
+	droplets := []godo.Droplet{
+		{ID: 11, Name: "web", Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.11", Type: "public"}}}, Tags: []string{"web"}},
+		{ID: 12, Name: "web", Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.12", Type: "public"}}}, Tags: []string{"web"}},
+		{ID: 13, Name: "db", Networks: &godo.Networks{V4: []godo.NetworkV4{{IPAddress: "203.0.113.13", Type: "public"}}}},
+	}
+
+	out, err := dropletsToAnsibleInventory(droplets, addressPublic, []string{"tag"}, defaultHostsUser)
+	require.NoError(t, err)
+	var inventory struct {
+		All struct {
+			Hosts    map[string]map[string]any `yaml:"hosts"`
+			Children map[string]struct {
+				Hosts map[string]any `yaml:"hosts"`
+			} `yaml:"children"`
+		} `yaml:"all"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(out), &inventory))
+	require.Len(t, inventory.All.Hosts, 3)
+	require.Equal(t, "203.0.113.11", inventory.All.Hosts["web-11"]["ansible_host"])
+	require.Equal(t, "203.0.113.12", inventory.All.Hosts["web-12"]["ansible_host"])
+	require.Equal(t, "203.0.113.13", inventory.All.Hosts["db"]["ansible_host"])
+	require.Contains(t, inventory.All.Children["tag_web"].Hosts, "web-11")
+	require.Contains(t, inventory.All.Children["tag_web"].Hosts, "web-12")
+
+	config := dropletsToSSHConfig(droplets, addressPublic, defaultHostsUser, "")
+	require.Contains(t, config, "Host web-11\n  HostName 203.0.113.11\n")
+	require.Contains(t, config, "Host web-12\n  HostName 203.0.113.12\n")
+	require.Contains(t, config, "Host db\n  HostName 203.0.113.13\n")
+	require.NotContains(t, config, "Host web\n")
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 
 	"github.com/digitalocean/godo"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -13,8 +17,43 @@ import (
 const (
 	defaultSizesPageSize = 50
 	defaultSizesPage     = 1
+
+	defaultRecommendLimit = 10
+	maxSizesPageSize      = 200
 )
 
+const (
+	sizeTargetDroplet  = "droplet"
+	sizeTargetDOKS     = "doks"
+	sizeTargetDatabase = "database"
+)
+
+// databaseSizeSpecPattern extracts the vCPU count and memory (GiB) encoded in database size slugs (e.g., db-s-2vcpu-4gb).
+var databaseSizeSpecPattern = regexp.MustCompile(`(\d+)vcpu-(\d+)gb`)
+
+// sizeRequirements holds the minimum resources and constraints a recommended size has to satisfy.
+type sizeRequirements struct {
+	MinVcpus        int
+	MinMemoryMB     int
+	MinDiskGB       int
+	MaxPriceMonthly float64
+	Region          string
+	AllowGPU        bool
+}
+
+// sizeRecommendation is a size slug that satisfies a set of sizeRequirements.
+type sizeRecommendation struct {
+	Slug         string  `json:"slug"`
+	Description  string  `json:"description,omitempty"`
+	Vcpus        int     `json:"vcpus"`
+	MemoryMB     int     `json:"memory_mb"`
+	DiskGB       int     `json:"disk_gb,omitempty"`
+	PriceMonthly float64 `json:"price_monthly,omitempty"`
+	PriceHourly  float64 `json:"price_hourly,omitempty"`
+	GPUCount     int     `json:"gpu_count,omitempty"`
+	NodeCounts   []int   `json:"node_counts,omitempty"`
+}
+
 // SizesTool provides tool-based handlers for DigitalOcean droplet sizes.
 type SizesTool struct {
 	client *godo.Client
@@ -69,6 +108,234 @@ func (s *SizesTool) listSizes(ctx context.Context, req mcp.CallToolRequest) (*mc
 	return mcp.NewToolResultText(string(jsonData)), nil
 }
 
+// recommendSizes ranks the size slugs of a droplet, DOKS node pool or database cluster that satisfy the requested resources.
+func (s *SizesTool) recommendSizes(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	requirements := sizeRequirements{}
+	if v, ok := args["MinVcpus"].(float64); ok {
+		requirements.MinVcpus = int(v)
+	}
+	if v, ok := args["MinMemoryGB"].(float64); ok {
+		requirements.MinMemoryMB = int(v * 1024)
+	}
+	if v, ok := args["MinDiskGB"].(float64); ok {
+		requirements.MinDiskGB = int(v)
+	}
+	if v, ok := args["MaxPriceMonthly"].(float64); ok {
+		requirements.MaxPriceMonthly = v
+	}
+	requirements.Region, _ = args["Region"].(string)
+	requirements.AllowGPU, _ = args["AllowGPU"].(bool)
+
+	limit := defaultRecommendLimit
+	if v, ok := args["Limit"].(float64); ok && int(v) > 0 {
+		limit = int(v)
+	}
+
+	target := sizeTargetDroplet
+	if v, ok := args["Target"].(string); ok && v != "" {
+		target = v
+	}
+
+	var recommendations []sizeRecommendation
+	switch target {
+	case sizeTargetDroplet, sizeTargetDOKS:
+		sizes, err := s.listAllSizes(ctx)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+
+		var allowed map[string]struct{}
+		if target == sizeTargetDOKS {
+			options, _, err := s.client.Kubernetes.GetOptions(ctx)
+			if err != nil {
+				return mcp.NewToolResultErrorFromErr("api error", err), nil
+			}
+			if requirements.Region != "" && !slices.ContainsFunc(options.Regions, func(r *godo.KubernetesRegion) bool { return r.Slug == requirements.Region }) {
+				return mcp.NewToolResultError(fmt.Sprintf("DOKS is not available in region %s", requirements.Region)), nil
+			}
+			allowed = make(map[string]struct{}, len(options.Sizes))
+			for _, size := range options.Sizes {
+				allowed[size.Slug] = struct{}{}
+			}
+		}
+		recommendations = rankSizes(sizes, allowed, requirements)
+	case sizeTargetDatabase:
+		engine, ok := args["Engine"].(string)
+		if !ok || engine == "" {
+			return mcp.NewToolResultError("Engine is required when Target is database"), nil
+		}
+		options, _, err := s.client.Databases.ListOptions(ctx)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		engineOptions, ok := databaseEngineOptions(options, engine)
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("unsupported database engine: %s", engine)), nil
+		}
+		if requirements.Region != "" && !slices.Contains(engineOptions.Regions, requirements.Region) {
+			return mcp.NewToolResultError(fmt.Sprintf("%s databases are not available in region %s", engine, requirements.Region)), nil
+		}
+		recommendations = rankDatabaseSizes(engineOptions.Layouts, requirements)
+	default:
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported target: %s, supported targets are: droplet, doks, database", target)), nil
+	}
+
+	if len(recommendations) > limit {
+		recommendations = recommendations[:limit]
+	}
+
+	jsonData, err := json.MarshalIndent(map[string]any{
+		"target":          target,
+		"region":          requirements.Region,
+		"recommendations": recommendations,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+
+	return mcp.NewToolResultText(string(jsonData)), nil
+}
+
+// listAllSizes walks every page of the size catalog.
+func (s *SizesTool) listAllSizes(ctx context.Context) ([]godo.Size, error) {
+	var all []godo.Size
+	opt := &godo.ListOptions{Page: 1, PerPage: maxSizesPageSize}
+	for {
+		sizes, resp, err := s.client.Sizes.List(ctx, opt)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, sizes...)
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			return all, nil
+		}
+		opt.Page++
+	}
+}
+
+// rankSizes filters sizes down to the ones satisfying the requirements and orders them from cheapest to most expensive.
+// If allowed is non-nil, only slugs contained in it are considered.
+func rankSizes(sizes []godo.Size, allowed map[string]struct{}, requirements sizeRequirements) []sizeRecommendation {
+	recommendations := make([]sizeRecommendation, 0)
+	for _, size := range sizes {
+		if allowed != nil {
+			if _, ok := allowed[size.Slug]; !ok {
+				continue
+			}
+		}
+		if !size.Available {
+			continue
+		}
+		if requirements.Region != "" && !slices.Contains(size.Regions, requirements.Region) {
+			continue
+		}
+		gpuCount := 0
+		if size.GPUInfo != nil {
+			gpuCount = size.GPUInfo.Count
+		}
+		if gpuCount > 0 && !requirements.AllowGPU {
+			continue
+		}
+		if size.Vcpus < requirements.MinVcpus || size.Memory < requirements.MinMemoryMB || size.Disk < requirements.MinDiskGB {
+			continue
+		}
+		if requirements.MaxPriceMonthly > 0 && size.PriceMonthly > requirements.MaxPriceMonthly {
+			continue
+		}
+		recommendations = append(recommendations, sizeRecommendation{
+			Slug:         size.Slug,
+			Description:  size.Description,
+			Vcpus:        size.Vcpus,
+			MemoryMB:     size.Memory,
+			DiskGB:       size.Disk,
+			PriceMonthly: size.PriceMonthly,
+			PriceHourly:  size.PriceHourly,
+			GPUCount:     gpuCount,
+		})
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		if a.PriceMonthly != b.PriceMonthly {
+			return a.PriceMonthly < b.PriceMonthly
+		}
+		if a.Vcpus != b.Vcpus {
+			return a.Vcpus < b.Vcpus
+		}
+		return a.MemoryMB < b.MemoryMB
+	})
+
+	return recommendations
+}
+
+// rankDatabaseSizes filters database size slugs by the resources encoded in the slug, smallest first.
+// The database options API does not expose prices, so price constraints are not applied.
+func rankDatabaseSizes(layouts []godo.DatabaseLayout, requirements sizeRequirements) []sizeRecommendation {
+	bySlug := make(map[string]*sizeRecommendation)
+	var order []string
+	for _, layout := range layouts {
+		for _, slug := range layout.Sizes {
+			if existing, ok := bySlug[slug]; ok {
+				existing.NodeCounts = append(existing.NodeCounts, layout.NodeNum)
+				continue
+			}
+			match := databaseSizeSpecPattern.FindStringSubmatch(slug)
+			if match == nil {
+				continue
+			}
+			vcpus, _ := strconv.Atoi(match[1])
+			memoryGB, _ := strconv.Atoi(match[2])
+			if vcpus < requirements.MinVcpus || memoryGB*1024 < requirements.MinMemoryMB {
+				continue
+			}
+			bySlug[slug] = &sizeRecommendation{
+				Slug:       slug,
+				Vcpus:      vcpus,
+				MemoryMB:   memoryGB * 1024,
+				NodeCounts: []int{layout.NodeNum},
+			}
+			order = append(order, slug)
+		}
+	}
+
+	recommendations := make([]sizeRecommendation, 0, len(order))
+	for _, slug := range order {
+		recommendations = append(recommendations, *bySlug[slug])
+	}
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		a, b := recommendations[i], recommendations[j]
+		if a.Vcpus != b.Vcpus {
+			return a.Vcpus < b.Vcpus
+		}
+		return a.MemoryMB < b.MemoryMB
+	})
+
+	return recommendations
+}
+
+// databaseEngineOptions returns the options of a database engine by its slug.
+func databaseEngineOptions(options *godo.DatabaseOptions, engine string) (godo.DatabaseEngineOptions, bool) {
+	switch engine {
+	case "pg":
+		return options.PostgresSQLOptions, true
+	case "mysql":
+		return options.MySQLOptions, true
+	case "redis":
+		return options.RedisOptions, true
+	case "valkey":
+		return options.ValkeyOptions, true
+	case "mongodb":
+		return options.MongoDBOptions, true
+	case "kafka":
+		return options.KafkaOptions, true
+	case "opensearch":
+		return options.OpensearchOptions, true
+	default:
+		return godo.DatabaseEngineOptions{}, false
+	}
+}
+
 // Tools returns the list of server tools for droplet sizes.
 func (s *SizesTool) Tools() []server.ServerTool {
 	return []server.ServerTool{
@@ -81,5 +348,21 @@ func (s *SizesTool) Tools() []server.ServerTool {
 				mcp.WithNumber("PerPage", mcp.DefaultNumber(defaultSizesPageSize), mcp.Description("Items per page")),
 			),
 		},
+		{
+			Handler: s.recommendSizes,
+			Tool: mcp.NewTool(
+				"size-recommend",
+				mcp.WithDescription("Recommend size slugs for a droplet, a DOKS node pool or a database cluster that meet the given requirements. Droplet and DOKS sizes are ranked from cheapest to most expensive with monthly and hourly prices; database sizes are ranked from smallest to largest."),
+				mcp.WithString("Target", mcp.DefaultString(sizeTargetDroplet), mcp.Description("What the size is for: 'droplet', 'doks' (node pool) or 'database'")),
+				mcp.WithString("Engine", mcp.Description("Database engine slug, required when Target is 'database' (pg, mysql, redis, valkey, mongodb, kafka, opensearch)")),
+				mcp.WithNumber("MinVcpus", mcp.Description("Minimum number of vCPUs")),
+				mcp.WithNumber("MinMemoryGB", mcp.Description("Minimum memory in GiB")),
+				mcp.WithNumber("MinDiskGB", mcp.Description("Minimum disk in GiB (droplet and DOKS only)")),
+				mcp.WithNumber("MaxPriceMonthly", mcp.Description("Maximum monthly price in USD (droplet and DOKS only)")),
+				mcp.WithString("Region", mcp.Description("Slug of the region the size must be available in (e.g., nyc3)")),
+				mcp.WithBoolean("AllowGPU", mcp.DefaultBool(false), mcp.Description("Whether GPU sizes may be recommended")),
+				mcp.WithNumber("Limit", mcp.DefaultNumber(defaultRecommendLimit), mcp.Description("Maximum number of recommendations to return")),
+			),
+		},
 	}
 }
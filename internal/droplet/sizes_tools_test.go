@@ -129,3 +129,205 @@ func TestSizesTool_listSizes(t *testing.T) {
 		})
 	}
 }
+
+func TestSizesTool_recommendSizes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testSizes := []godo.Size{
+		{Slug: "s-4vcpu-8gb", Vcpus: 4, Memory: 8192, Disk: 160, PriceMonthly: 48, PriceHourly: 0.07143, Available: true, Regions: []string{"nyc3", "ams3"}},
+		{Slug: "s-2vcpu-4gb", Vcpus: 2, Memory: 4096, Disk: 80, PriceMonthly: 24, PriceHourly: 0.03571, Available: true, Regions: []string{"nyc3"}},
+		{Slug: "s-1vcpu-1gb", Vcpus: 1, Memory: 1024, Disk: 25, PriceMonthly: 6, PriceHourly: 0.00893, Available: true, Regions: []string{"nyc3"}},
+		{Slug: "c-2", Vcpus: 2, Memory: 4096, Disk: 25, PriceMonthly: 42, PriceHourly: 0.0625, Available: true, Regions: []string{"ams3"}},
+		{Slug: "gpu-h100x1-80gb", Vcpus: 20, Memory: 245760, Disk: 720, PriceMonthly: 2500, Available: true, Regions: []string{"nyc3"}, GPUInfo: &godo.GPUInfo{Count: 1}},
+	}
+
+	kubernetesOptions := &godo.KubernetesOptions{
+		Regions: []*godo.KubernetesRegion{{Slug: "nyc3"}, {Slug: "ams3"}},
+		Sizes:   []*godo.KubernetesNodeSize{{Slug: "s-4vcpu-8gb"}, {Slug: "s-2vcpu-4gb"}, {Slug: "c-2"}, {Slug: "s-1vcpu-1gb"}},
+	}
+	databaseOptions := &godo.DatabaseOptions{
+		PostgresSQLOptions: godo.DatabaseEngineOptions{
+			Regions: []string{"nyc3", "ams3"},
+			Layouts: []godo.DatabaseLayout{
+				{NodeNum: 1, Sizes: []string{"db-s-1vcpu-1gb", "db-s-2vcpu-4gb", "db-s-4vcpu-8gb"}},
+				{NodeNum: 3, Sizes: []string{"db-s-2vcpu-4gb", "db-s-4vcpu-8gb"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		args            map[string]any
+		mockSetup       func(*MockSizesService)
+		kubernetesSetup func(*MockKubernetesService)
+		databasesSetup  func(*MockDatabasesService)
+		expectError     bool
+		expectedSlugs   []string
+	}{
+		{
+			name: "Cheapest sizes in region first",
+			args: map[string]any{"MinVcpus": float64(2), "MinMemoryGB": float64(4), "Region": "nyc3"},
+			mockSetup: func(m *MockSizesService) {
+				m.EXPECT().
+					List(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: 200}).
+					Return(testSizes, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectedSlugs: []string{"s-2vcpu-4gb", "s-4vcpu-8gb"},
+		},
+		{
+			name: "Max price and GPU allowed",
+			args: map[string]any{"MinVcpus": float64(2), "MaxPriceMonthly": float64(45), "AllowGPU": true},
+			mockSetup: func(m *MockSizesService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testSizes, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectedSlugs: []string{"s-2vcpu-4gb", "c-2"},
+		},
+		{
+			name: "DOKS node sizes in region",
+			args: map[string]any{"Target": "doks", "MinVcpus": float64(2), "Region": "ams3"},
+			mockSetup: func(m *MockSizesService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testSizes, &godo.Response{}, nil).
+					Times(1)
+			},
+			kubernetesSetup: func(m *MockKubernetesService) {
+				m.EXPECT().
+					GetOptions(gomock.Any()).
+					Return(kubernetesOptions, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectedSlugs: []string{"c-2", "s-4vcpu-8gb"},
+		},
+		{
+			name: "DOKS not available in region",
+			args: map[string]any{"Target": "doks", "Region": "sfo3"},
+			mockSetup: func(m *MockSizesService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(testSizes, &godo.Response{}, nil).
+					Times(1)
+			},
+			kubernetesSetup: func(m *MockKubernetesService) {
+				m.EXPECT().
+					GetOptions(gomock.Any()).
+					Return(kubernetesOptions, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "Database sizes in region",
+			args: map[string]any{"Target": "database", "Engine": "pg", "MinVcpus": float64(2), "Region": "ams3"},
+			databasesSetup: func(m *MockDatabasesService) {
+				m.EXPECT().
+					ListOptions(gomock.Any()).
+					Return(databaseOptions, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectedSlugs: []string{"db-s-2vcpu-4gb", "db-s-4vcpu-8gb"},
+		},
+		{
+			name: "Database engine not available in region",
+			args: map[string]any{"Target": "database", "Engine": "pg", "Region": "sgp1"},
+			databasesSetup: func(m *MockDatabasesService) {
+				m.EXPECT().
+					ListOptions(gomock.Any()).
+					Return(databaseOptions, &godo.Response{}, nil).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name: "API error",
+			args: map[string]any{},
+			mockSetup: func(m *MockSizesService) {
+				m.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported target",
+			args:        map[string]any{"Target": "volume"},
+			expectError: true,
+		},
+		{
+			name:        "Database target without engine",
+			args:        map[string]any{"Target": "database"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockSizes := NewMockSizesService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockSizes)
+			}
+			tool := setupSizesToolWithMock(mockSizes)
+			if tc.kubernetesSetup != nil {
+				mockKubernetes := NewMockKubernetesService(ctrl)
+				tc.kubernetesSetup(mockKubernetes)
+				tool.client.Kubernetes = mockKubernetes
+			}
+			if tc.databasesSetup != nil {
+				mockDatabases := NewMockDatabasesService(ctrl)
+				tc.databasesSetup(mockDatabases)
+				tool.client.Databases = mockDatabases
+			}
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.recommendSizes(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			var out struct {
+				Recommendations []sizeRecommendation `json:"recommendations"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+			slugs := make([]string, len(out.Recommendations))
+			for i, r := range out.Recommendations {
+				slugs[i] = r.Slug
+			}
+			require.Equal(t, tc.expectedSlugs, slugs)
+		})
+	}
+}
+
+func TestRankSizes_allowedSlugs(t *testing.T) {
+	sizes := []godo.Size{
+		{Slug: "s-1vcpu-2gb", Vcpus: 1, Memory: 2048, PriceMonthly: 12, Available: true},
+		{Slug: "s-2vcpu-4gb", Vcpus: 2, Memory: 4096, PriceMonthly: 24, Available: true},
+		{Slug: "s-1vcpu-512mb-10gb", Vcpus: 1, Memory: 512, PriceMonthly: 4, Available: true},
+	}
+	allowed := map[string]struct{}{"s-1vcpu-2gb": {}, "s-2vcpu-4gb": {}}
+
+	got := rankSizes(sizes, allowed, sizeRequirements{})
+	require.Len(t, got, 2)
+	require.Equal(t, "s-1vcpu-2gb", got[0].Slug)
+	require.Equal(t, "s-2vcpu-4gb", got[1].Slug)
+}
+
+func TestRankDatabaseSizes(t *testing.T) {
+	layouts := []godo.DatabaseLayout{
+		{NodeNum: 1, Sizes: []string{"db-s-1vcpu-1gb", "db-s-2vcpu-4gb", "db-s-4vcpu-8gb"}},
+		{NodeNum: 2, Sizes: []string{"db-s-2vcpu-4gb", "db-s-4vcpu-8gb"}},
+	}
+
+	got := rankDatabaseSizes(layouts, sizeRequirements{MinVcpus: 2, MinMemoryMB: 4096})
+	require.Len(t, got, 2)
+	require.Equal(t, "db-s-2vcpu-4gb", got[0].Slug)
+	require.Equal(t, 4096, got[0].MemoryMB)
+	require.Equal(t, []int{1, 2}, got[0].NodeCounts)
+	require.Equal(t, "db-s-4vcpu-8gb", got[1].Slug)
+}
@@ -0,0 +1,3 @@
+package functions
+
+//go:generate mockgen -destination=./mocks.go -package functions github.com/digitalocean/godo FunctionsService
@@ -0,0 +1,126 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// NamespaceTool provides Functions namespace management tools
+type NamespaceTool struct {
+	client *godo.Client
+}
+
+// NewNamespaceTool creates a new Functions namespace tool
+func NewNamespaceTool(client *godo.Client) *NamespaceTool {
+	return &NamespaceTool{
+		client: client,
+	}
+}
+
+// listNamespaces lists all Functions namespaces
+func (n *NamespaceTool) listNamespaces(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	namespaces, _, err := n.client.Functions.ListNamespaces(ctx)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonNamespaces, err := json.MarshalIndent(namespaces, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonNamespaces)), nil
+}
+
+// getNamespace fetches a Functions namespace by ID
+func (n *NamespaceTool) getNamespace(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Namespace ID is required"), nil
+	}
+	namespace, _, err := n.client.Functions.GetNamespace(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonNamespace, err := json.MarshalIndent(namespace, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonNamespace)), nil
+}
+
+// createNamespace creates a new Functions namespace
+func (n *NamespaceTool) createNamespace(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	label, ok := args["Label"].(string)
+	if !ok || label == "" {
+		return mcp.NewToolResultError("Namespace label is required"), nil
+	}
+	region, ok := args["Region"].(string)
+	if !ok || region == "" {
+		return mcp.NewToolResultError("Region is required"), nil
+	}
+
+	namespace, _, err := n.client.Functions.CreateNamespace(ctx, &godo.FunctionsNamespaceCreateRequest{
+		Label:  label,
+		Region: region,
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonNamespace, err := json.MarshalIndent(namespace, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonNamespace)), nil
+}
+
+// deleteNamespace deletes a Functions namespace, including its functions and triggers
+func (n *NamespaceTool) deleteNamespace(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Namespace ID is required"), nil
+	}
+	_, err := n.client.Functions.DeleteNamespace(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Namespace deleted successfully"), nil
+}
+
+// Tools returns a list of tool functions
+func (n *NamespaceTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: n.listNamespaces,
+			Tool: mcp.NewTool("functions-namespace-list",
+				mcp.WithDescription("List all Functions namespaces"),
+			),
+		},
+		{
+			Handler: n.getNamespace,
+			Tool: mcp.NewTool("functions-namespace-get",
+				mcp.WithDescription("Get a Functions namespace by ID, including its API host"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the namespace")),
+			),
+		},
+		{
+			Handler: n.createNamespace,
+			Tool: mcp.NewTool("functions-namespace-create",
+				mcp.WithDescription("Create a new Functions namespace"),
+				mcp.WithString("Label", mcp.Required(), mcp.Description("Label of the namespace")),
+				mcp.WithString("Region", mcp.Required(), mcp.Description("Slug of the region (e.g., 'ams3')")),
+			),
+		},
+		{
+			Handler: n.deleteNamespace,
+			Tool: mcp.NewTool("functions-namespace-delete",
+				mcp.WithDescription("Delete a Functions namespace, including all of its functions and triggers"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the namespace to delete")),
+			),
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupNamespaceToolWithMock(functions *MockFunctionsService) *NamespaceTool {
+	client := &godo.Client{}
+	client.Functions = functions
+	return NewNamespaceTool(client)
+}
+
+func TestNamespaceTool_createNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockFunctionsService)
+		expectError bool
+	}{
+		{
+			name: "Successful create",
+			args: map[string]any{"Label": "reports", "Region": "ams3"},
+			mockSetup: func(m *MockFunctionsService) {
+				m.EXPECT().
+					CreateNamespace(gomock.Any(), &godo.FunctionsNamespaceCreateRequest{Label: "reports", Region: "ams3"}).
+					Return(&godo.FunctionsNamespace{Namespace: "fn-123", Label: "reports", Region: "ams3"}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"Label": "reports", "Region": "ams3"},
+			mockSetup: func(m *MockFunctionsService) {
+				m.EXPECT().
+					CreateNamespace(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing region",
+			args:        map[string]any{"Label": "reports"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockFunctions := NewMockFunctionsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockFunctions)
+			}
+			tool := setupNamespaceToolWithMock(mockFunctions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createNamespace(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			require.Contains(t, resp.Content[0].(mcp.TextContent).Text, "fn-123")
+		})
+	}
+}
+
+func TestNamespaceTool_deleteNamespace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockFunctions := NewMockFunctionsService(ctrl)
+	mockFunctions.EXPECT().
+		DeleteNamespace(gomock.Any(), "fn-123").
+		Return(nil, nil).
+		Times(1)
+	tool := setupNamespaceToolWithMock(mockFunctions)
+
+	resp, err := tool.deleteNamespace(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": "fn-123"}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.deleteNamespace(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
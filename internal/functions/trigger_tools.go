@@ -0,0 +1,288 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// triggerTypeScheduled is the only trigger type supported by Functions.
+const triggerTypeScheduled = "SCHEDULED"
+
+// TriggerTool provides Functions trigger management tools
+type TriggerTool struct {
+	client *godo.Client
+}
+
+// NewTriggerTool creates a new Functions trigger tool
+func NewTriggerTool(client *godo.Client) *TriggerTool {
+	return &TriggerTool{
+		client: client,
+	}
+}
+
+// intervalToCron converts an interval in minutes to a cron expression. Intervals below an hour must divide an hour
+// and intervals of an hour or more must be a whole number of hours dividing a day, so the schedule stays regular.
+func intervalToCron(minutes int) (string, error) {
+	switch {
+	case minutes <= 0:
+		return "", fmt.Errorf("IntervalMinutes must be positive")
+	case minutes < 60:
+		if 60%minutes != 0 {
+			return "", fmt.Errorf("IntervalMinutes below 60 must divide 60, got %d", minutes)
+		}
+		if minutes == 1 {
+			return "* * * * *", nil
+		}
+		return fmt.Sprintf("*/%d * * * *", minutes), nil
+	case minutes%60 == 0 && 1440%minutes == 0:
+		hours := minutes / 60
+		switch hours {
+		case 1:
+			return "0 * * * *", nil
+		case 24:
+			return "0 0 * * *", nil
+		}
+		return fmt.Sprintf("0 */%d * * *", hours), nil
+	default:
+		return "", fmt.Errorf("IntervalMinutes of 60 or more must be a whole number of hours dividing 24 hours, got %d; use Cron instead", minutes)
+	}
+}
+
+// scheduleFromArgs returns the cron expression from the Cron or IntervalMinutes argument.
+// It returns an empty string when neither is provided.
+func scheduleFromArgs(args map[string]any) (string, error) {
+	cron, _ := args["Cron"].(string)
+	interval, hasInterval := args["IntervalMinutes"].(float64)
+	if cron != "" && hasInterval {
+		return "", fmt.Errorf("Cron and IntervalMinutes are mutually exclusive")
+	}
+	if hasInterval {
+		return intervalToCron(int(interval))
+	}
+	if cron == "" {
+		return "", nil
+	}
+	if fields := strings.Fields(cron); len(fields) != 5 {
+		return "", fmt.Errorf("Cron must have 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+	return cron, nil
+}
+
+// listTriggers lists the triggers of a namespace
+func (t *TriggerTool) listTriggers(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	namespace, ok := req.GetArguments()["NamespaceID"].(string)
+	if !ok || namespace == "" {
+		return mcp.NewToolResultError("Namespace ID is required"), nil
+	}
+	triggers, _, err := t.client.Functions.ListTriggers(ctx, namespace)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonTriggers, err := json.MarshalIndent(triggers, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTriggers)), nil
+}
+
+// getTrigger fetches a trigger by name, including its last and next run
+func (t *TriggerTool) getTrigger(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	namespace, ok := args["NamespaceID"].(string)
+	if !ok || namespace == "" {
+		return mcp.NewToolResultError("Namespace ID is required"), nil
+	}
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Trigger name is required"), nil
+	}
+	trigger, _, err := t.client.Functions.GetTrigger(ctx, namespace, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonTrigger, err := json.MarshalIndent(trigger, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTrigger)), nil
+}
+
+// createTrigger creates a scheduled trigger that invokes a function
+func (t *TriggerTool) createTrigger(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	namespace, ok := args["NamespaceID"].(string)
+	if !ok || namespace == "" {
+		return mcp.NewToolResultError("Namespace ID is required"), nil
+	}
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Trigger name is required"), nil
+	}
+	function, ok := args["Function"].(string)
+	if !ok || function == "" {
+		return mcp.NewToolResultError("Function is required"), nil
+	}
+	cron, err := scheduleFromArgs(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if cron == "" {
+		return mcp.NewToolResultError("Either Cron or IntervalMinutes is required"), nil
+	}
+	isEnabled := true
+	if v, ok := args["IsEnabled"].(bool); ok {
+		isEnabled = v
+	}
+	body, _ := args["Body"].(map[string]any)
+
+	trigger, _, err := t.client.Functions.CreateTrigger(ctx, namespace, &godo.FunctionsTriggerCreateRequest{
+		Name:      name,
+		Type:      triggerTypeScheduled,
+		Function:  function,
+		IsEnabled: isEnabled,
+		ScheduledDetails: &godo.TriggerScheduledDetails{
+			Cron: cron,
+			Body: body,
+		},
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonTrigger, err := json.MarshalIndent(trigger, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTrigger)), nil
+}
+
+// updateTrigger enables or disables a trigger, or changes its schedule or body
+func (t *TriggerTool) updateTrigger(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	namespace, ok := args["NamespaceID"].(string)
+	if !ok || namespace == "" {
+		return mcp.NewToolResultError("Namespace ID is required"), nil
+	}
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Trigger name is required"), nil
+	}
+	cron, err := scheduleFromArgs(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	updateRequest := &godo.FunctionsTriggerUpdateRequest{}
+	if v, ok := args["IsEnabled"].(bool); ok {
+		updateRequest.IsEnabled = &v
+	}
+	body, hasBody := args["Body"].(map[string]any)
+	if cron != "" || hasBody {
+		// The schedule is replaced as a whole, so keep the current cron or body when only one of them changes.
+		current, _, err := t.client.Functions.GetTrigger(ctx, namespace, name)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		details := &godo.TriggerScheduledDetails{}
+		if current.ScheduledDetails != nil {
+			*details = *current.ScheduledDetails
+		}
+		if cron != "" {
+			details.Cron = cron
+		}
+		if hasBody {
+			details.Body = body
+		}
+		updateRequest.ScheduledDetails = details
+	}
+	if updateRequest.IsEnabled == nil && updateRequest.ScheduledDetails == nil {
+		return mcp.NewToolResultError("At least one of IsEnabled, Cron, IntervalMinutes or Body is required"), nil
+	}
+
+	trigger, _, err := t.client.Functions.UpdateTrigger(ctx, namespace, name, updateRequest)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonTrigger, err := json.MarshalIndent(trigger, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTrigger)), nil
+}
+
+// deleteTrigger deletes a trigger
+func (t *TriggerTool) deleteTrigger(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	namespace, ok := args["NamespaceID"].(string)
+	if !ok || namespace == "" {
+		return mcp.NewToolResultError("Namespace ID is required"), nil
+	}
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Trigger name is required"), nil
+	}
+	_, err := t.client.Functions.DeleteTrigger(ctx, namespace, name)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Trigger deleted successfully"), nil
+}
+
+// Tools returns a list of tool functions
+func (t *TriggerTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: t.listTriggers,
+			Tool: mcp.NewTool("functions-trigger-list",
+				mcp.WithDescription("List the triggers of a Functions namespace"),
+				mcp.WithString("NamespaceID", mcp.Required(), mcp.Description("ID of the namespace")),
+			),
+		},
+		{
+			Handler: t.getTrigger,
+			Tool: mcp.NewTool("functions-trigger-get",
+				mcp.WithDescription("Get a trigger, including its schedule and last and next run"),
+				mcp.WithString("NamespaceID", mcp.Required(), mcp.Description("ID of the namespace")),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the trigger")),
+			),
+		},
+		{
+			Handler: t.createTrigger,
+			Tool: mcp.NewTool("functions-trigger-create",
+				mcp.WithDescription("Create a scheduled trigger that invokes a function. Provide either Cron or IntervalMinutes."),
+				mcp.WithString("NamespaceID", mcp.Required(), mcp.Description("ID of the namespace")),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the trigger")),
+				mcp.WithString("Function", mcp.Required(), mcp.Description("Name of the function to invoke, including its package (e.g., 'reports/daily')")),
+				mcp.WithString("Cron", mcp.Description("Cron expression with 5 fields, in UTC (e.g., '*/5 * * * *')")),
+				mcp.WithNumber("IntervalMinutes", mcp.Description("Run every N minutes, as an alternative to Cron (e.g., 5, 15, 60, 360)")),
+				mcp.WithObject("Body", mcp.Description("JSON body passed to the function on each run")),
+				mcp.WithBoolean("IsEnabled", mcp.DefaultBool(true), mcp.Description("Whether the trigger is enabled")),
+			),
+		},
+		{
+			Handler: t.updateTrigger,
+			Tool: mcp.NewTool("functions-trigger-update",
+				mcp.WithDescription("Update a trigger. Only the provided attributes are changed."),
+				mcp.WithString("NamespaceID", mcp.Required(), mcp.Description("ID of the namespace")),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the trigger")),
+				mcp.WithString("Cron", mcp.Description("New cron expression with 5 fields, in UTC")),
+				mcp.WithNumber("IntervalMinutes", mcp.Description("Run every N minutes, as an alternative to Cron")),
+				mcp.WithObject("Body", mcp.Description("New JSON body passed to the function on each run")),
+				mcp.WithBoolean("IsEnabled", mcp.Description("Enable or disable the trigger")),
+			),
+		},
+		{
+			Handler: t.deleteTrigger,
+			Tool: mcp.NewTool("functions-trigger-delete",
+				mcp.WithDescription("Delete a trigger"),
+				mcp.WithString("NamespaceID", mcp.Required(), mcp.Description("ID of the namespace")),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the trigger")),
+			),
+		},
+	}
+}
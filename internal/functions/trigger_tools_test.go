@@ -0,0 +1,183 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupTriggerToolWithMock(functions *MockFunctionsService) *TriggerTool {
+	client := &godo.Client{}
+	client.Functions = functions
+	return NewTriggerTool(client)
+}
+
+func TestIntervalToCron(t *testing.T) {
+	tests := []struct {
+		minutes     int
+		expected    string
+		expectError bool
+	}{
+		{minutes: 1, expected: "* * * * *"},
+		{minutes: 5, expected: "*/5 * * * *"},
+		{minutes: 60, expected: "0 * * * *"},
+		{minutes: 360, expected: "0 */6 * * *"},
+		{minutes: 1440, expected: "0 0 * * *"},
+		{minutes: 7, expectError: true},
+		{minutes: 90, expectError: true},
+		{minutes: 0, expectError: true},
+	}
+	for _, tc := range tests {
+		cron, err := intervalToCron(tc.minutes)
+		if tc.expectError {
+			require.Error(t, err, "minutes=%d", tc.minutes)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, cron)
+	}
+}
+
+func TestTriggerTool_createTrigger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockFunctionsService)
+		expectError bool
+	}{
+		{
+			name: "Every 5 minutes",
+			args: map[string]any{
+				"NamespaceID":     "fn-123",
+				"Name":            "refresh",
+				"Function":        "reports/refresh",
+				"IntervalMinutes": float64(5),
+				"Body":            map[string]any{"full": true},
+			},
+			mockSetup: func(m *MockFunctionsService) {
+				m.EXPECT().
+					CreateTrigger(gomock.Any(), "fn-123", &godo.FunctionsTriggerCreateRequest{
+						Name:             "refresh",
+						Type:             "SCHEDULED",
+						Function:         "reports/refresh",
+						IsEnabled:        true,
+						ScheduledDetails: &godo.TriggerScheduledDetails{Cron: "*/5 * * * *", Body: map[string]any{"full": true}},
+					}).
+					Return(&godo.FunctionsTrigger{Name: "refresh"}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "Disabled cron trigger",
+			args: map[string]any{
+				"NamespaceID": "fn-123",
+				"Name":        "nightly",
+				"Function":    "reports/nightly",
+				"Cron":        "0 2 * * *",
+				"IsEnabled":   false,
+			},
+			mockSetup: func(m *MockFunctionsService) {
+				m.EXPECT().
+					CreateTrigger(gomock.Any(), "fn-123", &godo.FunctionsTriggerCreateRequest{
+						Name:             "nightly",
+						Type:             "SCHEDULED",
+						Function:         "reports/nightly",
+						ScheduledDetails: &godo.TriggerScheduledDetails{Cron: "0 2 * * *"},
+					}).
+					Return(&godo.FunctionsTrigger{Name: "nightly"}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"NamespaceID": "fn-123", "Name": "refresh", "Function": "reports/refresh", "Cron": "* * * * *"},
+			mockSetup: func(m *MockFunctionsService) {
+				m.EXPECT().
+					CreateTrigger(gomock.Any(), "fn-123", gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing schedule",
+			args:        map[string]any{"NamespaceID": "fn-123", "Name": "refresh", "Function": "reports/refresh"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid cron",
+			args:        map[string]any{"NamespaceID": "fn-123", "Name": "refresh", "Function": "reports/refresh", "Cron": "every 5 minutes"},
+			expectError: true,
+		},
+		{
+			name:        "Cron and interval together",
+			args:        map[string]any{"NamespaceID": "fn-123", "Name": "refresh", "Function": "reports/refresh", "Cron": "* * * * *", "IntervalMinutes": float64(5)},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockFunctions := NewMockFunctionsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockFunctions)
+			}
+			tool := setupTriggerToolWithMock(mockFunctions)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createTrigger(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+		})
+	}
+}
+
+func TestTriggerTool_updateTrigger(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	enabled := false
+	mockFunctions := NewMockFunctionsService(ctrl)
+	mockFunctions.EXPECT().
+		GetTrigger(gomock.Any(), "fn-123", "refresh").
+		Return(&godo.FunctionsTrigger{
+			Name:             "refresh",
+			ScheduledDetails: &godo.TriggerScheduledDetails{Cron: "*/5 * * * *", Body: map[string]any{"full": true}},
+		}, nil, nil).
+		Times(1)
+	mockFunctions.EXPECT().
+		UpdateTrigger(gomock.Any(), "fn-123", "refresh", &godo.FunctionsTriggerUpdateRequest{
+			IsEnabled:        &enabled,
+			ScheduledDetails: &godo.TriggerScheduledDetails{Cron: "*/15 * * * *", Body: map[string]any{"full": true}},
+		}).
+		Return(&godo.FunctionsTrigger{Name: "refresh"}, nil, nil).
+		Times(1)
+	tool := setupTriggerToolWithMock(mockFunctions)
+
+	resp, err := tool.updateTrigger(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"NamespaceID":     "fn-123",
+		"Name":            "refresh",
+		"IntervalMinutes": float64(15),
+		"IsEnabled":       false,
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.updateTrigger(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"NamespaceID": "fn-123",
+		"Name":        "refresh",
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -0,0 +1,354 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// alertPolicyRule is a single alert policy of a template.
+type alertPolicyRule struct {
+	Name        string               `json:"name"`
+	Type        string               `json:"type"`
+	Description string               `json:"description"`
+	Compare     godo.AlertPolicyComp `json:"compare"`
+	Value       float32              `json:"value"`
+	Window      string               `json:"window"`
+}
+
+// alertPolicyTemplate is a named set of alert policies that are applied together.
+type alertPolicyTemplate struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Rules       []alertPolicyRule `json:"rules"`
+}
+
+// alertPolicyTemplates is the library of templates, in the order they are listed.
+var alertPolicyTemplates = []alertPolicyTemplate{
+	{
+		Name:        "droplet-standard",
+		Description: "CPU, memory and disk utilization alerts for droplets",
+		Rules: []alertPolicyRule{
+			{Name: "cpu", Type: godo.DropletCPUUtilizationPercent, Description: "CPU is running high", Compare: godo.GreaterThan, Value: 80, Window: "5m"},
+			{Name: "memory", Type: godo.DropletMemoryUtilizationPercent, Description: "Memory utilization is running high", Compare: godo.GreaterThan, Value: 90, Window: "5m"},
+			{Name: "disk", Type: godo.DropletDiskUtilizationPercent, Description: "Disk utilization is running high", Compare: godo.GreaterThan, Value: 85, Window: "5m"},
+		},
+	},
+	{
+		Name:        "droplet-extended",
+		Description: "The droplet-standard alerts plus load average and outbound bandwidth alerts",
+		Rules: []alertPolicyRule{
+			{Name: "cpu", Type: godo.DropletCPUUtilizationPercent, Description: "CPU is running high", Compare: godo.GreaterThan, Value: 80, Window: "5m"},
+			{Name: "memory", Type: godo.DropletMemoryUtilizationPercent, Description: "Memory utilization is running high", Compare: godo.GreaterThan, Value: 90, Window: "5m"},
+			{Name: "disk", Type: godo.DropletDiskUtilizationPercent, Description: "Disk utilization is running high", Compare: godo.GreaterThan, Value: 85, Window: "5m"},
+			{Name: "load_5", Type: godo.DropletFiveMinuteLoadAverage, Description: "5 minute load average is running high", Compare: godo.GreaterThan, Value: 4, Window: "10m"},
+			{Name: "outbound_bandwidth", Type: godo.DropletPublicOutboundBandwidthRate, Description: "Public outbound bandwidth is running high (Mbps)", Compare: godo.GreaterThan, Value: 1000, Window: "10m"},
+		},
+	},
+	{
+		Name:        "load-balancer-standard",
+		Description: "CPU, connection, TLS and backend health alerts for load balancers",
+		Rules: []alertPolicyRule{
+			{Name: "cpu", Type: godo.LoadBalancerCPUUtilizationPercent, Description: "Load balancer CPU is running high", Compare: godo.GreaterThan, Value: 80, Window: "5m"},
+			{Name: "connections", Type: godo.LoadBalancerConnectionUtilizationPercent, Description: "Load balancer connection utilization is running high", Compare: godo.GreaterThan, Value: 80, Window: "5m"},
+			{Name: "tls_connections", Type: godo.LoadBalancerTLSUtilizationPercent, Description: "Load balancer TLS connection rate utilization is running high", Compare: godo.GreaterThan, Value: 80, Window: "5m"},
+			{Name: "droplet_health", Type: godo.LoadBalancerDropletHealth, Description: "Load balancer backend droplets are failing health checks", Compare: godo.GreaterThan, Value: 0, Window: "5m"},
+		},
+	},
+	{
+		Name:        "database-standard",
+		Description: "CPU, memory and disk utilization alerts for database clusters",
+		Rules: []alertPolicyRule{
+			{Name: "cpu", Type: godo.DbaasCPUUtilizationPercent, Description: "Database CPU is running high", Compare: godo.GreaterThan, Value: 80, Window: "5m"},
+			{Name: "memory", Type: godo.DbaasMemoryUtilizationPercent, Description: "Database memory utilization is running high", Compare: godo.GreaterThan, Value: 90, Window: "5m"},
+			{Name: "disk", Type: godo.DbaasDiskUtilizationPercent, Description: "Database disk utilization is running high", Compare: godo.GreaterThan, Value: 85, Window: "5m"},
+		},
+	},
+}
+
+// findAlertPolicyTemplate returns the template with the given name.
+func findAlertPolicyTemplate(name string) (alertPolicyTemplate, bool) {
+	for _, t := range alertPolicyTemplates {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return alertPolicyTemplate{}, false
+}
+
+// alertPolicyTemplateNames returns the names of all templates.
+func alertPolicyTemplateNames() []string {
+	names := make([]string, len(alertPolicyTemplates))
+	for i, t := range alertPolicyTemplates {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// templateApplyResult reports what happened to a single rule of an applied template.
+type templateApplyResult struct {
+	Rule   string `json:"rule"`
+	Type   string `json:"type"`
+	Action string `json:"action"`
+	UUID   string `json:"uuid,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// AlertPolicyTemplateTool provides alert policy template tools
+type AlertPolicyTemplateTool struct {
+	client *godo.Client
+}
+
+// NewAlertPolicyTemplateTool creates a new alert policy template tool
+func NewAlertPolicyTemplateTool(client *godo.Client) *AlertPolicyTemplateTool {
+	return &AlertPolicyTemplateTool{
+		client: client,
+	}
+}
+
+// stringsFromArgs reads an array of strings argument.
+func stringsFromArgs(args map[string]any, key string) []string {
+	var values []string
+	if arr, ok := args[key].([]any); ok {
+		for _, v := range arr {
+			if s, ok := v.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
+// alertsFromArgs reads the Alerts argument. The second return value is false when the argument is omitted.
+func alertsFromArgs(args map[string]any) (godo.Alerts, bool) {
+	var alerts godo.Alerts
+	alertsMap, ok := args["Alerts"].(map[string]any)
+	if !ok {
+		return alerts, false
+	}
+	alerts.Email = stringsFromArgs(alertsMap, "Email")
+	if rawSlack, ok := alertsMap["Slack"].([]any); ok {
+		for _, v := range rawSlack {
+			if slackMap, ok := v.(map[string]any); ok {
+				url, _ := slackMap["URL"].(string)
+				channel, _ := slackMap["Channel"].(string)
+				alerts.Slack = append(alerts.Slack, godo.SlackDetails{URL: url, Channel: channel})
+			}
+		}
+	}
+	return alerts, true
+}
+
+// sameTargets reports whether two lists contain the same values, ignoring order.
+func sameTargets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	return reflect.DeepEqual(sortedA, sortedB)
+}
+
+// sameAlerts reports whether two notification settings are equal, treating missing and empty lists alike.
+func sameAlerts(a, b godo.Alerts) bool {
+	if !sameTargets(a.Email, b.Email) || len(a.Slack) != len(b.Slack) {
+		return false
+	}
+	for i := range a.Slack {
+		if a.Slack[i] != b.Slack[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matchingPolicy finds an existing policy for the same metric and the same tags and entities.
+func matchingPolicy(policies []godo.AlertPolicy, alertType string, tags, entities []string) *godo.AlertPolicy {
+	for i := range policies {
+		p := &policies[i]
+		if p.Type == alertType && sameTargets(p.Tags, tags) && sameTargets(p.Entities, entities) {
+			return p
+		}
+	}
+	return nil
+}
+
+// listTemplates lists the available alert policy templates
+func (a *AlertPolicyTemplateTool) listTemplates(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	jsonTemplates, err := json.MarshalIndent(alertPolicyTemplates, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonTemplates)), nil
+}
+
+// applyTemplate creates the policies of a template for a set of tags or resources, updating existing policies for
+// the same metric and targets in place
+func (a *AlertPolicyTemplateTool) applyTemplate(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, _ := args["Template"].(string)
+	template, ok := findAlertPolicyTemplate(name)
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown template %q, available templates are: %s", name, strings.Join(alertPolicyTemplateNames(), ", "))), nil
+	}
+	tags := stringsFromArgs(args, "Tags")
+	entities := stringsFromArgs(args, "Entities")
+	if len(tags) == 0 && len(entities) == 0 {
+		return mcp.NewToolResultError("At least one of Tags or Entities is required"), nil
+	}
+	alerts, hasAlerts := alertsFromArgs(args)
+	enabled := true
+	if v, ok := args["Enabled"].(bool); ok {
+		enabled = v
+	}
+	overrides, _ := args["Overrides"].(map[string]any)
+	for rule := range overrides {
+		if !templateHasRule(template, rule) {
+			return mcp.NewToolResultError(fmt.Sprintf("template %q has no rule %q", template.Name, rule)), nil
+		}
+	}
+	dryRun, _ := args["DryRun"].(bool)
+
+	existing, err := listAllPages(func(opt *godo.ListOptions) ([]godo.AlertPolicy, *godo.Response, error) {
+		return a.client.Monitoring.ListAlertPolicies(ctx, opt)
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	results := make([]templateApplyResult, 0, len(template.Rules))
+	for _, rule := range template.Rules {
+		value := rule.Value
+		if v, ok := overrides[rule.Name].(float64); ok {
+			value = float32(v)
+		}
+		result := templateApplyResult{Rule: rule.Name, Type: rule.Type}
+		desired := godo.AlertPolicyUpdateRequest{
+			Type:        rule.Type,
+			Description: fmt.Sprintf("[%s] %s", template.Name, rule.Description),
+			Compare:     rule.Compare,
+			Value:       value,
+			Window:      rule.Window,
+			Entities:    entities,
+			Tags:        tags,
+			Alerts:      alerts,
+			Enabled:     &enabled,
+		}
+
+		current := matchingPolicy(existing, rule.Type, tags, entities)
+		if current == nil {
+			result.Action = "create"
+			if !dryRun {
+				createRequest := godo.AlertPolicyCreateRequest(desired)
+				policy, _, err := a.client.Monitoring.CreateAlertPolicy(ctx, &createRequest)
+				if err != nil {
+					result.Error = err.Error()
+				} else {
+					result.UUID = policy.UUID
+				}
+			}
+			results = append(results, result)
+			continue
+		}
+
+		result.UUID = current.UUID
+		if !hasAlerts {
+			// Keep the notification settings of the existing policy when none are given.
+			desired.Alerts = current.Alerts
+		}
+		if current.Description == desired.Description && current.Compare == desired.Compare &&
+			current.Value == desired.Value && current.Window == desired.Window &&
+			current.Enabled == enabled && sameAlerts(current.Alerts, desired.Alerts) {
+			result.Action = "unchanged"
+			results = append(results, result)
+			continue
+		}
+		result.Action = "update"
+		if !dryRun {
+			if _, _, err := a.client.Monitoring.UpdateAlertPolicy(ctx, current.UUID, &desired); err != nil {
+				result.Error = err.Error()
+			}
+		}
+		results = append(results, result)
+	}
+
+	jsonResults, err := json.MarshalIndent(map[string]any{
+		"template": template.Name,
+		"dry_run":  dryRun,
+		"results":  results,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResults)), nil
+}
+
+// templateHasRule reports whether a template has a rule with the given name.
+func templateHasRule(template alertPolicyTemplate, name string) bool {
+	for _, rule := range template.Rules {
+		if rule.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Tools returns a list of tool functions
+func (a *AlertPolicyTemplateTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: a.listTemplates,
+			Tool: mcp.NewTool("alert-policy-template-list",
+				mcp.WithDescription("List the alert policy templates with the metric, comparison, threshold and window of each policy"),
+			),
+		},
+		{
+			Handler: a.applyTemplate,
+			Tool: mcp.NewTool("alert-policy-template-apply",
+				mcp.WithDescription("Apply an alert policy template to a set of tags or resources. Existing policies for the same metric and the same tags and resources are updated in place instead of being duplicated."),
+				mcp.WithString("Template", mcp.Required(), mcp.Enum(alertPolicyTemplateNames()...), mcp.Description("Name of the template to apply")),
+				mcp.WithArray("Tags", mcp.Description("Tags of the resources to monitor (e.g., 'production')"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
+				mcp.WithArray("Entities", mcp.Description("IDs of the resources to monitor (e.g., Droplet IDs: '12345678', '23456789')"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
+				mcp.WithObject("Alerts", mcp.Description("Alert notification settings, same as alert-policy-create. Existing policies keep their settings when omitted."),
+					mcp.Properties(map[string]any{
+						"Email": map[string]any{
+							"type": "array",
+							"items": map[string]any{
+								"type": "string",
+							},
+							"description": "List of email addresses to receive alert notifications",
+						},
+						"Slack": map[string]any{
+							"type": "array",
+							"items": map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"URL":     map[string]any{"type": "string", "description": "Slack webhook URL"},
+									"Channel": map[string]any{"type": "string", "description": "Slack channel (e.g., '#alerts')"},
+								},
+							},
+							"description": "List of Slack webhook configurations",
+						},
+					})),
+				mcp.WithObject("Overrides", mcp.Description("Threshold overrides by rule name (e.g., {\"cpu\": 90})")),
+				mcp.WithBoolean("Enabled", mcp.DefaultBool(true), mcp.Description("Whether the policies are enabled")),
+				mcp.WithBoolean("DryRun", mcp.DefaultBool(false), mcp.Description("Report the policies that would be created or updated without changing anything")),
+			),
+		},
+	}
+}
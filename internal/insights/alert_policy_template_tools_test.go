@@ -0,0 +1,194 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupAlertPolicyTemplateToolWithMock(mockMonitoring *MockMonitoringService) *AlertPolicyTemplateTool {
+	client := &godo.Client{}
+	client.Monitoring = mockMonitoring
+	return NewAlertPolicyTemplateTool(client)
+}
+
+func TestAlertPolicyTemplateTool_applyTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	enabled := true
+	alerts := godo.Alerts{Email: []string{"ops@example.com"}}
+	existingCPU := godo.AlertPolicy{
+		UUID:        "cpu-1",
+		Type:        godo.DropletCPUUtilizationPercent,
+		Description: "CPU alert",
+		Compare:     godo.GreaterThan,
+		Value:       70,
+		Window:      "5m",
+		Tags:        []string{"production"},
+		Entities:    []string{},
+		Alerts:      alerts,
+		Enabled:     true,
+	}
+	existingMemory := godo.AlertPolicy{
+		UUID:        "memory-1",
+		Type:        godo.DropletMemoryUtilizationPercent,
+		Description: "[droplet-standard] Memory utilization is running high",
+		Compare:     godo.GreaterThan,
+		Value:       90,
+		Window:      "5m",
+		Tags:        []string{"production"},
+		Alerts:      godo.Alerts{Email: []string{"ops@example.com"}, Slack: []godo.SlackDetails{}},
+		Enabled:     true,
+	}
+	// Same metric for another tag must not be touched.
+	otherDisk := godo.AlertPolicy{UUID: "disk-1", Type: godo.DropletDiskUtilizationPercent, Tags: []string{"staging"}}
+
+	tests := []struct {
+		name            string
+		args            map[string]any
+		mockSetup       func(*MockMonitoringService)
+		expectError     bool
+		expectedActions map[string]string
+	}{
+		{
+			name: "Create, update and keep policies",
+			args: map[string]any{
+				"Template":  "droplet-standard",
+				"Tags":      []any{"production"},
+				"Overrides": map[string]any{"disk": float64(75)},
+			},
+			mockSetup: func(m *MockMonitoringService) {
+				m.EXPECT().
+					ListAlertPolicies(gomock.Any(), &godo.ListOptions{Page: 1, PerPage: 200}).
+					Return([]godo.AlertPolicy{existingCPU, existingMemory, otherDisk}, nil, nil).
+					Times(1)
+				m.EXPECT().
+					UpdateAlertPolicy(gomock.Any(), "cpu-1", &godo.AlertPolicyUpdateRequest{
+						Type:        godo.DropletCPUUtilizationPercent,
+						Description: "[droplet-standard] CPU is running high",
+						Compare:     godo.GreaterThan,
+						Value:       80,
+						Window:      "5m",
+						Tags:        []string{"production"},
+						Alerts:      alerts,
+						Enabled:     &enabled,
+					}).
+					Return(&existingCPU, nil, nil).
+					Times(1)
+				m.EXPECT().
+					CreateAlertPolicy(gomock.Any(), &godo.AlertPolicyCreateRequest{
+						Type:        godo.DropletDiskUtilizationPercent,
+						Description: "[droplet-standard] Disk utilization is running high",
+						Compare:     godo.GreaterThan,
+						Value:       75,
+						Window:      "5m",
+						Tags:        []string{"production"},
+						Enabled:     &enabled,
+					}).
+					Return(&godo.AlertPolicy{UUID: "disk-2"}, nil, nil).
+					Times(1)
+			},
+			expectedActions: map[string]string{"cpu": "update", "memory": "unchanged", "disk": "create"},
+		},
+		{
+			name: "Dry run",
+			args: map[string]any{
+				"Template": "load-balancer-standard",
+				"Entities": []any{"lb-1"},
+				"DryRun":   true,
+			},
+			mockSetup: func(m *MockMonitoringService) {
+				m.EXPECT().
+					ListAlertPolicies(gomock.Any(), gomock.Any()).
+					Return(nil, nil, nil).
+					Times(1)
+			},
+			expectedActions: map[string]string{"cpu": "create", "connections": "create", "tls_connections": "create", "droplet_health": "create"},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"Template": "droplet-standard", "Tags": []any{"production"}},
+			mockSetup: func(m *MockMonitoringService) {
+				m.EXPECT().
+					ListAlertPolicies(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Unknown template",
+			args:        map[string]any{"Template": "droplet-gold", "Tags": []any{"production"}},
+			expectError: true,
+		},
+		{
+			name:        "Unknown override",
+			args:        map[string]any{"Template": "droplet-standard", "Tags": []any{"production"}, "Overrides": map[string]any{"gpu": float64(50)}},
+			expectError: true,
+		},
+		{
+			name:        "Missing targets",
+			args:        map[string]any{"Template": "droplet-standard"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMonitoring := NewMockMonitoringService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockMonitoring)
+			}
+			tool := setupAlertPolicyTemplateToolWithMock(mockMonitoring)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.applyTemplate(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+
+			var out struct {
+				Results []templateApplyResult `json:"results"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+			actions := map[string]string{}
+			for _, r := range out.Results {
+				require.Empty(t, r.Error)
+				actions[r.Rule] = r.Action
+			}
+			require.Equal(t, tc.expectedActions, actions)
+		})
+	}
+}
+
+func TestAlertPolicyTemplates_types(t *testing.T) {
+	// The alert policy types accepted by the monitoring API, as defined by godo.
+	validTypes := map[string]bool{
+		godo.DropletCPUUtilizationPercent:             true,
+		godo.DropletMemoryUtilizationPercent:          true,
+		godo.DropletDiskUtilizationPercent:            true,
+		godo.DropletFiveMinuteLoadAverage:             true,
+		godo.DropletPublicOutboundBandwidthRate:       true,
+		godo.LoadBalancerCPUUtilizationPercent:        true,
+		godo.LoadBalancerConnectionUtilizationPercent: true,
+		godo.LoadBalancerTLSUtilizationPercent:        true,
+		godo.LoadBalancerDropletHealth:                true,
+		godo.DbaasCPUUtilizationPercent:               true,
+		godo.DbaasMemoryUtilizationPercent:            true,
+		godo.DbaasDiskUtilizationPercent:              true,
+	}
+	for _, template := range alertPolicyTemplates {
+		for _, rule := range template.Rules {
+			require.True(t, validTypes[rule.Type], "template %s rule %s has unknown type %s", template.Name, rule.Name, rule.Type)
+		}
+	}
+}
@@ -17,13 +17,15 @@ const (
 
 // AlertPolicyTool provides alert policy management tools
 type AlertPolicyTool struct {
-	client *godo.Client
+	client   *godo.Client
+	channels *notificationChannelStore
 }
 
 // NewAlertPolicyTool creates a new alert policy tool
 func NewAlertPolicyTool(client *godo.Client) *AlertPolicyTool {
 	return &AlertPolicyTool{
-		client: client,
+		client:   client,
+		channels: newNotificationChannelStore(defaultNotificationChannelsPath()),
 	}
 }
 
@@ -128,6 +130,12 @@ func (c *AlertPolicyTool) createAlertPolicy(ctx context.Context, req mcp.CallToo
 		}
 	}
 
+	// Add the named notification channels
+	alerts, err := notificationsFromArgs(c.channels, req.GetArguments(), alerts)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	enabled := true
 	if v, ok := req.GetArguments()["Enabled"].(bool); ok {
 		enabled = v
@@ -220,6 +228,12 @@ func (c *AlertPolicyTool) updateAlertPolicy(ctx context.Context, req mcp.CallToo
 		}
 	}
 
+	// Add the named notification channels
+	alerts, err := notificationsFromArgs(c.channels, req.GetArguments(), alerts)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
 	enabled := true
 	if v, ok := req.GetArguments()["Enabled"].(bool); ok {
 		enabled = v
@@ -341,6 +355,10 @@ Database metrics:
 							"description": "List of Slack webhook configurations",
 						},
 					})),
+				mcp.WithArray("Channels", mcp.Description("Names of notification channels to notify in addition to the Alerts settings (see notification-channel-list)"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
 				mcp.WithBoolean("Enabled", mcp.Description("Whether the alert policy is enabled (true) or disabled (false)")),
 			),
 		},
@@ -403,6 +421,10 @@ Database metrics:
 							"description": "List of Slack webhook configurations",
 						},
 					})),
+				mcp.WithArray("Channels", mcp.Description("Names of notification channels to notify in addition to the Alerts settings (see notification-channel-list)"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
 				mcp.WithBoolean("Enabled", mcp.Description("Whether the alert policy is enabled (true) or disabled (false)")),
 			),
 		},
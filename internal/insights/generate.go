@@ -1,3 +1,3 @@
 package insights
 
-//go:generate mockgen -destination=./mocks.go -package insights github.com/digitalocean/godo UptimeChecksService,MonitoringService
+//go:generate mockgen -destination=./mocks.go -package insights github.com/digitalocean/godo UptimeChecksService,MonitoringService,DomainsService,AppsService
@@ -0,0 +1,499 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultMetricsLastHours = 1
+	maxMetricsRange         = 31 * 24 * time.Hour
+)
+
+// dropletMetricsFunc fetches a single droplet metric.
+type dropletMetricsFunc func(godo.MonitoringService, context.Context, *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error)
+
+// loadBalancerMetricsFunc fetches a single load balancer metric.
+type loadBalancerMetricsFunc func(godo.MonitoringService, context.Context, *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error)
+
+// rawDropletMetric is a droplet metric that is summarized as returned by the API.
+type rawDropletMetric struct {
+	unit  string
+	fetch dropletMetricsFunc
+}
+
+// rawDropletMetrics maps metric names to the droplet metrics that need no derivation.
+var rawDropletMetrics = map[string]rawDropletMetric{
+	"load_1":           {unit: "load", fetch: godo.MonitoringService.GetDropletLoad1},
+	"load_5":           {unit: "load", fetch: godo.MonitoringService.GetDropletLoad5},
+	"load_15":          {unit: "load", fetch: godo.MonitoringService.GetDropletLoad15},
+	"memory_available": {unit: "bytes", fetch: godo.MonitoringService.GetDropletAvailableMemory},
+	"memory_free":      {unit: "bytes", fetch: godo.MonitoringService.GetDropletFreeMemory},
+	"memory_cached":    {unit: "bytes", fetch: godo.MonitoringService.GetDropletCachedMemory},
+	"memory_total":     {unit: "bytes", fetch: godo.MonitoringService.GetDropletTotalMemory},
+	"filesystem_free":  {unit: "bytes", fetch: godo.MonitoringService.GetDropletFilesystemFree},
+	"filesystem_size":  {unit: "bytes", fetch: godo.MonitoringService.GetDropletFilesystemSize},
+}
+
+// dropletMetricNames lists every supported droplet metric, including the derived ones.
+var dropletMetricNames = []string{
+	"cpu", "memory", "filesystem", "bandwidth",
+	"load_1", "load_5", "load_15",
+	"memory_available", "memory_free", "memory_cached", "memory_total",
+	"filesystem_free", "filesystem_size",
+}
+
+// loadBalancerMetric is a load balancer metric and its unit.
+type loadBalancerMetric struct {
+	unit  string
+	fetch loadBalancerMetricsFunc
+}
+
+// loadBalancerMetrics maps metric names to the load balancer metrics.
+var loadBalancerMetrics = map[string]loadBalancerMetric{
+	"frontend_http_requests_per_second":     {unit: "requests/s", fetch: godo.MonitoringService.GetLoadBalancerFrontendHttpRequestsPerSecond},
+	"frontend_connections_current":          {unit: "connections", fetch: godo.MonitoringService.GetLoadBalancerFrontendConnectionsCurrent},
+	"frontend_connections_limit":            {unit: "connections", fetch: godo.MonitoringService.GetLoadBalancerFrontendConnectionsLimit},
+	"frontend_cpu_utilization":              {unit: "percent", fetch: godo.MonitoringService.GetLoadBalancerFrontendCpuUtilization},
+	"frontend_network_throughput_http":      {unit: "bytes/s", fetch: godo.MonitoringService.GetLoadBalancerFrontendNetworkThroughputHttp},
+	"frontend_network_throughput_tcp":       {unit: "bytes/s", fetch: godo.MonitoringService.GetLoadBalancerFrontendNetworkThroughputTcp},
+	"frontend_network_throughput_udp":       {unit: "bytes/s", fetch: godo.MonitoringService.GetLoadBalancerFrontendNetworkThroughputUdp},
+	"frontend_http_responses":               {unit: "responses/s", fetch: godo.MonitoringService.GetLoadBalancerFrontendHttpResponses},
+	"frontend_tls_connections_current":      {unit: "connections", fetch: godo.MonitoringService.GetLoadBalancerFrontendTlsConnectionsCurrent},
+	"frontend_firewall_dropped_packets":     {unit: "packets/s", fetch: godo.MonitoringService.GetLoadBalancerFrontendFirewallDroppedPackets},
+	"droplets_http_response_time_avg":       {unit: "seconds", fetch: godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTimeAvg},
+	"droplets_http_response_time_p50":       {unit: "seconds", fetch: godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTime50P},
+	"droplets_http_response_time_p95":       {unit: "seconds", fetch: godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTime95P},
+	"droplets_http_response_time_p99":       {unit: "seconds", fetch: godo.MonitoringService.GetLoadBalancerDropletsHttpResponseTime99P},
+	"droplets_http_session_duration_avg":    {unit: "seconds", fetch: godo.MonitoringService.GetLoadBalancerDropletsHttpSessionDurationAvg},
+	"droplets_queue_size":                   {unit: "requests", fetch: godo.MonitoringService.GetLoadBalancerDropletsQueueSize},
+	"droplets_http_responses":               {unit: "responses/s", fetch: godo.MonitoringService.GetLoadBalancerDropletsHttpResponses},
+	"droplets_connections":                  {unit: "connections", fetch: godo.MonitoringService.GetLoadBalancerDropletsConnections},
+	"droplets_health_checks":                {unit: "status", fetch: godo.MonitoringService.GetLoadBalancerDropletsHealthChecks},
+	"droplets_downtime":                     {unit: "seconds", fetch: godo.MonitoringService.GetLoadBalancerDropletsDowntime},
+	"frontend_tls_connections_rate_limited": {unit: "connections/s", fetch: godo.MonitoringService.GetLoadBalancerFrontendTlsConnectionsExceedingRateLimit},
+}
+
+// metricPoint is a single sample of a series.
+type metricPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// seriesSummary summarizes the samples of a series.
+type seriesSummary struct {
+	Labels map[string]string `json:"labels,omitempty"`
+	Count  int               `json:"count"`
+	Min    float64           `json:"min"`
+	MinAt  time.Time         `json:"min_at"`
+	Max    float64           `json:"max"`
+	MaxAt  time.Time         `json:"max_at"`
+	Avg    float64           `json:"avg"`
+	P95    float64           `json:"p95"`
+	Points []metricPoint     `json:"points,omitempty"`
+}
+
+// metricsReport is the output of a metrics query.
+type metricsReport struct {
+	Metric string           `json:"metric"`
+	Unit   string           `json:"unit"`
+	Start  time.Time        `json:"start"`
+	End    time.Time        `json:"end"`
+	Series []*seriesSummary `json:"series"`
+}
+
+// MetricsTool provides droplet and load balancer monitoring metrics tools
+type MetricsTool struct {
+	client *godo.Client
+}
+
+// NewMetricsTool creates a new metrics tool
+func NewMetricsTool(client *godo.Client) *MetricsTool {
+	return &MetricsTool{
+		client: client,
+	}
+}
+
+// round2 rounds a value to two decimals to keep the output readable.
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// summarize computes min, max, avg and p95 of a series. Points are kept when includePoints is set.
+func summarize(labels map[string]string, points []metricPoint, includePoints bool) *seriesSummary {
+	summary := &seriesSummary{Labels: labels, Count: len(points)}
+	if len(points) == 0 {
+		return summary
+	}
+	values := make([]float64, len(points))
+	sum := 0.0
+	summary.Min, summary.MinAt = points[0].Value, points[0].Time
+	summary.Max, summary.MaxAt = points[0].Value, points[0].Time
+	for i, p := range points {
+		values[i] = p.Value
+		sum += p.Value
+		if p.Value < summary.Min {
+			summary.Min, summary.MinAt = p.Value, p.Time
+		}
+		if p.Value > summary.Max {
+			summary.Max, summary.MaxAt = p.Value, p.Time
+		}
+	}
+	sort.Float64s(values)
+	summary.Min = round2(summary.Min)
+	summary.Max = round2(summary.Max)
+	summary.Avg = round2(sum / float64(len(points)))
+	summary.P95 = round2(percentile(values, 95))
+	if includePoints {
+		summary.Points = make([]metricPoint, len(points))
+		for i, p := range points {
+			summary.Points[i] = metricPoint{Time: p.Time, Value: round2(p.Value)}
+		}
+	}
+	return summary
+}
+
+// streamPoints converts the samples of a stream to points.
+func streamPoints(stream metrics.SampleStream) []metricPoint {
+	points := make([]metricPoint, len(stream.Values))
+	for i, v := range stream.Values {
+		points[i] = metricPoint{Time: v.Timestamp.Time().UTC(), Value: float64(v.Value)}
+	}
+	return points
+}
+
+// streamLabels converts the labels of a stream to a plain map.
+func streamLabels(stream metrics.SampleStream) map[string]string {
+	if len(stream.Metric) == 0 {
+		return nil
+	}
+	labels := make(map[string]string, len(stream.Metric))
+	for k, v := range stream.Metric {
+		labels[string(k)] = string(v)
+	}
+	return labels
+}
+
+// summarizeStreams summarizes every stream of a response as its own series.
+func summarizeStreams(resp *godo.MetricsResponse, includePoints bool) []*seriesSummary {
+	series := []*seriesSummary{}
+	for _, stream := range resp.Data.Result {
+		series = append(series, summarize(streamLabels(stream), streamPoints(stream), includePoints))
+	}
+	return series
+}
+
+// sumByTimestamp adds up the samples of every stream of a response per timestamp.
+func sumByTimestamp(resp *godo.MetricsResponse) map[metrics.Time]float64 {
+	sums := map[metrics.Time]float64{}
+	for _, stream := range resp.Data.Result {
+		for _, v := range stream.Values {
+			sums[v.Timestamp] += float64(v.Value)
+		}
+	}
+	return sums
+}
+
+// sortedTimestamps returns the keys of a per-timestamp map in order.
+func sortedTimestamps(m map[metrics.Time]float64) []metrics.Time {
+	timestamps := make([]metrics.Time, 0, len(m))
+	for ts := range m {
+		timestamps = append(timestamps, ts)
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+	return timestamps
+}
+
+// usedPercent derives the used percentage from total and free series, matched by timestamp.
+func usedPercent(total, free *godo.MetricsResponse) []metricPoint {
+	totals := sumByTimestamp(total)
+	frees := sumByTimestamp(free)
+	points := []metricPoint{}
+	for _, ts := range sortedTimestamps(totals) {
+		f, ok := frees[ts]
+		if !ok || totals[ts] <= 0 {
+			continue
+		}
+		points = append(points, metricPoint{Time: ts.Time().UTC(), Value: (totals[ts] - f) / totals[ts] * 100})
+	}
+	return points
+}
+
+// cpuPercent derives the CPU utilization from the per-mode CPU time counters. Utilization between two samples is the
+// share of non-idle time in the total time spent, across all CPUs.
+func cpuPercent(resp *godo.MetricsResponse) []metricPoint {
+	totals := map[metrics.Time]float64{}
+	idles := map[metrics.Time]float64{}
+	for _, stream := range resp.Data.Result {
+		idle := stream.Metric["mode"] == "idle"
+		for _, v := range stream.Values {
+			totals[v.Timestamp] += float64(v.Value)
+			if idle {
+				idles[v.Timestamp] += float64(v.Value)
+			}
+		}
+	}
+
+	points := []metricPoint{}
+	timestamps := sortedTimestamps(totals)
+	for i := 1; i < len(timestamps); i++ {
+		prev, cur := timestamps[i-1], timestamps[i]
+		dTotal := totals[cur] - totals[prev]
+		dIdle := idles[cur] - idles[prev]
+		// Counters reset when the droplet reboots.
+		if dTotal <= 0 || dIdle < 0 {
+			continue
+		}
+		points = append(points, metricPoint{Time: cur.Time().UTC(), Value: (dTotal - dIdle) / dTotal * 100})
+	}
+	return points
+}
+
+// metricsTimeRange reads the Start and End arguments, or LastHours before now when they are omitted.
+func metricsTimeRange(args map[string]any) (time.Time, time.Time, error) {
+	end := time.Now().UTC()
+	if v, ok := args["End"].(string); ok && v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid End %q, expected RFC3339 (e.g., 2025-01-02T03:00:00Z)", v)
+		}
+		end = t
+	}
+
+	lastHours := float64(defaultMetricsLastHours)
+	if v, ok := args["LastHours"].(float64); ok && v > 0 {
+		lastHours = v
+	}
+	start := end.Add(-time.Duration(lastHours * float64(time.Hour)))
+	if v, ok := args["Start"].(string); ok && v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid Start %q, expected RFC3339 (e.g., 2025-01-02T02:00:00Z)", v)
+		}
+		start = t
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("Start must be before End")
+	}
+	if end.Sub(start) > maxMetricsRange {
+		return time.Time{}, time.Time{}, fmt.Errorf("time range must not exceed %d days", int(maxMetricsRange.Hours()/24))
+	}
+	return start, end, nil
+}
+
+// hostIDFromArgs reads the droplet ID, accepting a number or a string.
+func hostIDFromArgs(args map[string]any) string {
+	switch v := args["HostID"].(type) {
+	case float64:
+		return strconv.Itoa(int(v))
+	case string:
+		return v
+	}
+	return ""
+}
+
+// getDropletMetrics queries a droplet metric over a time range and summarizes it
+func (m *MetricsTool) getDropletMetrics(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	hostID := hostIDFromArgs(args)
+	if hostID == "" {
+		return mcp.NewToolResultError("HostID is required"), nil
+	}
+	metric, _ := args["Metric"].(string)
+	if metric == "" {
+		return mcp.NewToolResultError("Metric is required"), nil
+	}
+	start, end, err := metricsTimeRange(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	includePoints, _ := args["IncludePoints"].(bool)
+
+	request := &godo.DropletMetricsRequest{HostID: hostID, Start: start, End: end}
+	report := &metricsReport{Metric: metric, Start: start, End: end}
+	monitoring := m.client.Monitoring
+
+	switch metric {
+	case "cpu":
+		resp, _, err := monitoring.GetDropletCPU(ctx, request)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		report.Unit = "percent"
+		report.Series = []*seriesSummary{summarize(nil, cpuPercent(resp), includePoints)}
+	case "memory":
+		total, _, err := monitoring.GetDropletTotalMemory(ctx, request)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		available, _, err := monitoring.GetDropletAvailableMemory(ctx, request)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		report.Unit = "percent"
+		report.Series = []*seriesSummary{summarize(nil, usedPercent(total, available), includePoints)}
+	case "filesystem":
+		size, _, err := monitoring.GetDropletFilesystemSize(ctx, request)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		free, _, err := monitoring.GetDropletFilesystemFree(ctx, request)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		report.Unit = "percent"
+		report.Series = []*seriesSummary{summarize(nil, usedPercent(size, free), includePoints)}
+	case "bandwidth":
+		iface, _ := args["Interface"].(string)
+		if iface == "" {
+			iface = "public"
+		}
+		direction, _ := args["Direction"].(string)
+		if direction == "" {
+			direction = "outbound"
+		}
+		if iface != "public" && iface != "private" {
+			return mcp.NewToolResultError("Interface must be 'public' or 'private'"), nil
+		}
+		if direction != "inbound" && direction != "outbound" {
+			return mcp.NewToolResultError("Direction must be 'inbound' or 'outbound'"), nil
+		}
+		resp, _, err := monitoring.GetDropletBandwidth(ctx, &godo.DropletBandwidthMetricsRequest{
+			DropletMetricsRequest: *request,
+			Interface:             iface,
+			Direction:             direction,
+		})
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		report.Metric = fmt.Sprintf("bandwidth_%s_%s", iface, direction)
+		report.Unit = "Mbps"
+		report.Series = summarizeStreams(resp, includePoints)
+	default:
+		raw, ok := rawDropletMetrics[metric]
+		if !ok {
+			return mcp.NewToolResultError(fmt.Sprintf("unsupported metric %q, supported metrics are: %s", metric, strings.Join(dropletMetricNames, ", "))), nil
+		}
+		resp, _, err := raw.fetch(monitoring, ctx, request)
+		if err != nil {
+			return mcp.NewToolResultErrorFromErr("api error", err), nil
+		}
+		report.Unit = raw.unit
+		report.Series = summarizeStreams(resp, includePoints)
+	}
+
+	jsonReport, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonReport)), nil
+}
+
+// getLoadBalancerMetrics queries a load balancer metric over a time range and summarizes it
+func (m *MetricsTool) getLoadBalancerMetrics(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	lbID, _ := args["LoadBalancerID"].(string)
+	if lbID == "" {
+		return mcp.NewToolResultError("LoadBalancerID is required"), nil
+	}
+	metric, _ := args["Metric"].(string)
+	lbMetric, ok := loadBalancerMetrics[metric]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unsupported metric %q, supported metrics are: %s", metric, strings.Join(loadBalancerMetricNames(), ", "))), nil
+	}
+	start, end, err := metricsTimeRange(args)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	includePoints, _ := args["IncludePoints"].(bool)
+
+	resp, _, err := lbMetric.fetch(m.client.Monitoring, ctx, &godo.LoadBalancerMetricsRequest{LoadBalancerID: lbID, Start: start, End: end})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	report := &metricsReport{
+		Metric: metric,
+		Unit:   lbMetric.unit,
+		Start:  start,
+		End:    end,
+		Series: summarizeStreams(resp, includePoints),
+	}
+
+	jsonReport, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonReport)), nil
+}
+
+// loadBalancerMetricNames returns the supported load balancer metric names in order.
+func loadBalancerMetricNames() []string {
+	names := make([]string, 0, len(loadBalancerMetrics))
+	for name := range loadBalancerMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// metricsTimeRangeOptions returns the tool options describing the time range of a query
+func metricsTimeRangeOptions() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("Start", mcp.Description("Start of the time range in RFC3339 (e.g., 2025-01-02T02:00:00Z). Defaults to LastHours before End.")),
+		mcp.WithString("End", mcp.Description("End of the time range in RFC3339. Defaults to now.")),
+		mcp.WithNumber("LastHours", mcp.DefaultNumber(defaultMetricsLastHours), mcp.Description("Length of the time range in hours, used when Start is omitted")),
+		mcp.WithBoolean("IncludePoints", mcp.DefaultBool(false), mcp.Description("Include the individual samples in addition to the summary")),
+	}
+}
+
+// Tools returns a list of tool functions
+func (m *MetricsTool) Tools() []server.ServerTool {
+	dropletOptions := append([]mcp.ToolOption{
+		mcp.WithDescription("Query a droplet metric over a time range. Returns min, max, avg and p95 with the times of the min and max. 'cpu', 'memory' and 'filesystem' are utilization percentages."),
+		mcp.WithString("HostID", mcp.Required(), mcp.Description("ID of the droplet")),
+		mcp.WithString("Metric", mcp.Required(), mcp.Enum(dropletMetricNames...), mcp.Description("Metric to query")),
+		mcp.WithString("Interface", mcp.DefaultString("public"), mcp.Description("Network interface for the bandwidth metric: 'public' or 'private'")),
+		mcp.WithString("Direction", mcp.DefaultString("outbound"), mcp.Description("Traffic direction for the bandwidth metric: 'inbound' or 'outbound'")),
+	}, metricsTimeRangeOptions()...)
+
+	loadBalancerOptions := append([]mcp.ToolOption{
+		mcp.WithDescription("Query a load balancer metric over a time range. Returns min, max, avg and p95 per series with the times of the min and max."),
+		mcp.WithString("LoadBalancerID", mcp.Required(), mcp.Description("ID of the load balancer")),
+		mcp.WithString("Metric", mcp.Required(), mcp.Enum(loadBalancerMetricNames()...), mcp.Description("Metric to query")),
+	}, metricsTimeRangeOptions()...)
+
+	return []server.ServerTool{
+		{
+			Handler: m.getDropletMetrics,
+			Tool:    mcp.NewTool("droplet-metrics-get", dropletOptions...),
+		},
+		{
+			Handler: m.getLoadBalancerMetrics,
+			Tool:    mcp.NewTool("load-balancer-metrics-get", loadBalancerOptions...),
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/digitalocean/godo"
+	"github.com/digitalocean/godo/metrics"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupMetricsToolWithMock(mockMonitoring *MockMonitoringService) *MetricsTool {
+	client := &godo.Client{}
+	client.Monitoring = mockMonitoring
+	return NewMetricsTool(client)
+}
+
+// metricsResponse builds a response with one stream per label set, sampled every minute from the unix epoch.
+func metricsResponse(streams map[string][]float64, label string) *godo.MetricsResponse {
+	resp := &godo.MetricsResponse{Status: "success"}
+	for value, samples := range streams {
+		stream := metrics.SampleStream{}
+		if label != "" {
+			stream.Metric = metrics.Metric{metrics.LabelName(label): metrics.LabelValue(value)}
+		}
+		for i, v := range samples {
+			stream.Values = append(stream.Values, metrics.SamplePair{
+				Timestamp: metrics.TimeFromUnix(int64(i * 60)),
+				Value:     metrics.SampleValue(v),
+			})
+		}
+		resp.Data.Result = append(resp.Data.Result, stream)
+	}
+	return resp
+}
+
+func TestSummarize(t *testing.T) {
+	base := time.Unix(0, 0).UTC()
+	points := []metricPoint{}
+	for i := 1; i <= 20; i++ {
+		points = append(points, metricPoint{Time: base.Add(time.Duration(i) * time.Minute), Value: float64(i)})
+	}
+
+	summary := summarize(nil, points, false)
+	require.Equal(t, 20, summary.Count)
+	require.Equal(t, 1.0, summary.Min)
+	require.Equal(t, base.Add(time.Minute), summary.MinAt)
+	require.Equal(t, 20.0, summary.Max)
+	require.Equal(t, base.Add(20*time.Minute), summary.MaxAt)
+	require.Equal(t, 10.5, summary.Avg)
+	require.Equal(t, 19.0, summary.P95)
+	require.Empty(t, summary.Points)
+
+	require.Len(t, summarize(nil, points, true).Points, 20)
+	require.Equal(t, 0, summarize(nil, nil, false).Count)
+}
+
+func TestCPUPercent(t *testing.T) {
+	// Two CPUs worth of counters: 30s of the 60s spent idle between each sample.
+	resp := metricsResponse(map[string][]float64{
+		"idle":   {100, 130, 145},
+		"user":   {50, 70, 110},
+		"system": {10, 20, 25},
+	}, "mode")
+
+	points := cpuPercent(resp)
+	require.Len(t, points, 2)
+	require.InDelta(t, 50.0, points[0].Value, 0.001)
+	require.InDelta(t, 75.0, points[1].Value, 0.001)
+}
+
+func TestMetricsTimeRange(t *testing.T) {
+	start, end, err := metricsTimeRange(map[string]any{"End": "2025-01-02T03:00:00Z", "LastHours": float64(2)})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 1, 2, 1, 0, 0, 0, time.UTC), start)
+	require.Equal(t, time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC), end)
+
+	_, _, err = metricsTimeRange(map[string]any{"Start": "2025-01-02T03:00:00Z", "End": "2025-01-02T01:00:00Z"})
+	require.Error(t, err)
+
+	_, _, err = metricsTimeRange(map[string]any{"Start": "yesterday"})
+	require.Error(t, err)
+}
+
+func TestMetricsTool_getDropletMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockMonitoringService)
+		expectError bool
+		expectAvg   float64
+	}{
+		{
+			name: "Memory used percent",
+			args: map[string]any{"HostID": float64(123), "Metric": "memory"},
+			mockSetup: func(m *MockMonitoringService) {
+				m.EXPECT().
+					GetDropletTotalMemory(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *godo.DropletMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+						require.Equal(t, "123", req.HostID)
+						require.Equal(t, time.Hour, req.End.Sub(req.Start))
+						return metricsResponse(map[string][]float64{"": {1000, 1000}}, ""), nil, nil
+					}).
+					Times(1)
+				m.EXPECT().
+					GetDropletAvailableMemory(gomock.Any(), gomock.Any()).
+					Return(metricsResponse(map[string][]float64{"": {500, 300}}, ""), nil, nil).
+					Times(1)
+			},
+			expectAvg: 60,
+		},
+		{
+			name: "Load",
+			args: map[string]any{"HostID": "123", "Metric": "load_5"},
+			mockSetup: func(m *MockMonitoringService) {
+				m.EXPECT().
+					GetDropletLoad5(gomock.Any(), gomock.Any()).
+					Return(metricsResponse(map[string][]float64{"": {1, 2, 3}}, ""), nil, nil).
+					Times(1)
+			},
+			expectAvg: 2,
+		},
+		{
+			name: "Bandwidth",
+			args: map[string]any{"HostID": "123", "Metric": "bandwidth", "Direction": "inbound"},
+			mockSetup: func(m *MockMonitoringService) {
+				m.EXPECT().
+					GetDropletBandwidth(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *godo.DropletBandwidthMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+						require.Equal(t, "public", req.Interface)
+						require.Equal(t, "inbound", req.Direction)
+						return metricsResponse(map[string][]float64{"": {4, 6}}, ""), nil, nil
+					}).
+					Times(1)
+			},
+			expectAvg: 5,
+		},
+		{
+			name: "API error",
+			args: map[string]any{"HostID": "123", "Metric": "cpu"},
+			mockSetup: func(m *MockMonitoringService) {
+				m.EXPECT().
+					GetDropletCPU(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Unsupported metric",
+			args:        map[string]any{"HostID": "123", "Metric": "disk_io"},
+			expectError: true,
+		},
+		{
+			name:        "Missing HostID",
+			args:        map[string]any{"Metric": "cpu"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockMonitoring := NewMockMonitoringService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockMonitoring)
+			}
+			tool := setupMetricsToolWithMock(mockMonitoring)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.getDropletMetrics(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+			var report metricsReport
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &report))
+			require.Len(t, report.Series, 1)
+			require.Equal(t, tc.expectAvg, report.Series[0].Avg)
+		})
+	}
+}
+
+func TestMetricsTool_getLoadBalancerMetrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMonitoring := NewMockMonitoringService(ctrl)
+	mockMonitoring.EXPECT().
+		GetLoadBalancerDropletsHttpResponseTime95P(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *godo.LoadBalancerMetricsRequest) (*godo.MetricsResponse, *godo.Response, error) {
+			require.Equal(t, "lb-1", req.LoadBalancerID)
+			return metricsResponse(map[string][]float64{"10.0.0.1": {0.1, 0.3}, "10.0.0.2": {0.2, 0.2}}, "droplet_ip"), nil, nil
+		}).
+		Times(1)
+	tool := setupMetricsToolWithMock(mockMonitoring)
+
+	resp, err := tool.getLoadBalancerMetrics(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"LoadBalancerID": "lb-1",
+		"Metric":         "droplets_http_response_time_p95",
+		"LastHours":      float64(6),
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var report metricsReport
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &report))
+	require.Equal(t, "seconds", report.Unit)
+	require.Len(t, report.Series, 2)
+
+	resp, err = tool.getLoadBalancerMetrics(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"LoadBalancerID": "lb-1",
+		"Metric":         "unknown",
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
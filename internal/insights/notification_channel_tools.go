@@ -0,0 +1,473 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// notificationChannelsFileEnv overrides the location of the notification channels file.
+const notificationChannelsFileEnv = "DIGITALOCEAN_NOTIFICATION_CHANNELS_FILE"
+
+// notificationChannel is a named set of email addresses and Slack webhooks.
+type notificationChannel struct {
+	Emails []string            `json:"emails,omitempty"`
+	Slack  []godo.SlackDetails `json:"slack,omitempty"`
+}
+
+// notificationChannelStore keeps the notification channels in a local JSON file.
+type notificationChannelStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// defaultNotificationChannelsPath returns the notification channels file from the environment, or the
+// notification-channels.json file in the user configuration directory.
+func defaultNotificationChannelsPath() string {
+	if path := os.Getenv(notificationChannelsFileEnv); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "mcp-digitalocean", "notification-channels.json")
+}
+
+// newNotificationChannelStore creates a store backed by the given file.
+func newNotificationChannelStore(path string) *notificationChannelStore {
+	return &notificationChannelStore{path: path}
+}
+
+// load reads all channels. A missing file holds no channels.
+func (s *notificationChannelStore) load() (map[string]notificationChannel, error) {
+	channels := map[string]notificationChannel{}
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return channels, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification channels file %s: %w", s.path, err)
+	}
+	if err := json.Unmarshal(data, &channels); err != nil {
+		return nil, fmt.Errorf("failed to parse notification channels file %s: %w", s.path, err)
+	}
+	return channels, nil
+}
+
+// save writes all channels. The file is only readable by the owner since it holds webhook URLs.
+func (s *notificationChannelStore) save(channels map[string]notificationChannel) error {
+	data, err := json.MarshalIndent(channels, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create notification channels directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write notification channels file %s: %w", s.path, err)
+	}
+	return nil
+}
+
+// resolve returns the notification settings of the named channels combined.
+func (s *notificationChannelStore) resolve(names []string) (godo.Alerts, error) {
+	var alerts godo.Alerts
+	if len(names) == 0 {
+		return alerts, nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channels, err := s.load()
+	if err != nil {
+		return alerts, err
+	}
+	for _, name := range names {
+		channel, ok := channels[name]
+		if !ok {
+			return alerts, fmt.Errorf("unknown notification channel %q", name)
+		}
+		alerts = mergeAlerts(alerts, godo.Alerts{Email: channel.Emails, Slack: channel.Slack})
+	}
+	return alerts, nil
+}
+
+// mergeAlerts adds the email addresses and Slack webhooks of b to a, skipping duplicates.
+func mergeAlerts(a, b godo.Alerts) godo.Alerts {
+	merged := godo.Alerts{
+		Email: append([]string(nil), a.Email...),
+		Slack: append([]godo.SlackDetails(nil), a.Slack...),
+	}
+	for _, email := range b.Email {
+		if !containsString(merged.Email, email) {
+			merged.Email = append(merged.Email, email)
+		}
+	}
+	for _, slack := range b.Slack {
+		if !containsSlack(merged.Slack, slack) {
+			merged.Slack = append(merged.Slack, slack)
+		}
+	}
+	return merged
+}
+
+// channelUse describes how alerts use a notification channel.
+type channelUse int
+
+const (
+	// channelUnused means the alerts notify none of the channel targets.
+	channelUnused channelUse = iota
+	// channelPartial means the alerts notify some, but not all, of the channel targets. The shared targets may
+	// belong to another channel, so such alerts are not rewired.
+	channelPartial
+	// channelUsed means the alerts notify every target of the channel.
+	channelUsed
+)
+
+// rewireAlerts replaces the email addresses and Slack webhooks of one channel by those of another. The alerts are
+// only rewired when they notify every target of the channel; otherwise they are returned unchanged.
+func rewireAlerts(alerts godo.Alerts, from, to notificationChannel) (godo.Alerts, channelUse) {
+	var rewired godo.Alerts
+	matched := 0
+	for _, email := range alerts.Email {
+		if containsString(from.Emails, email) {
+			matched++
+			continue
+		}
+		rewired.Email = append(rewired.Email, email)
+	}
+	for _, slack := range alerts.Slack {
+		if containsSlack(from.Slack, slack) {
+			matched++
+			continue
+		}
+		rewired.Slack = append(rewired.Slack, slack)
+	}
+	switch {
+	case matched == 0:
+		return alerts, channelUnused
+	case !containsAllTargets(alerts, from):
+		return alerts, channelPartial
+	}
+	return mergeAlerts(rewired, godo.Alerts{Email: to.Emails, Slack: to.Slack}), channelUsed
+}
+
+// containsAllTargets reports whether the alerts notify every email address and Slack webhook of a channel.
+func containsAllTargets(alerts godo.Alerts, channel notificationChannel) bool {
+	for _, email := range channel.Emails {
+		if !containsString(alerts.Email, email) {
+			return false
+		}
+	}
+	for _, slack := range channel.Slack {
+		if !containsSlack(alerts.Slack, slack) {
+			return false
+		}
+	}
+	return true
+}
+
+// containsString matches email addresses case-insensitively.
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsSlack matches Slack webhooks by URL, since the channel of a webhook is fixed when it is created.
+func containsSlack(values []godo.SlackDetails, value godo.SlackDetails) bool {
+	for _, v := range values {
+		if v.URL == value.URL {
+			return true
+		}
+	}
+	return false
+}
+
+// rewireResult reports an alert that was moved to another channel, or skipped because it only shares some targets
+// with the channel.
+type rewireResult struct {
+	Kind    string `json:"kind"`
+	ID      string `json:"id"`
+	CheckID string `json:"check_id,omitempty"`
+	Name    string `json:"name"`
+	Error   string `json:"error,omitempty"`
+}
+
+// NotificationChannelTool provides named notification channel tools
+type NotificationChannelTool struct {
+	client   *godo.Client
+	channels *notificationChannelStore
+}
+
+// NewNotificationChannelTool creates a new notification channel tool
+func NewNotificationChannelTool(client *godo.Client) *NotificationChannelTool {
+	return &NotificationChannelTool{
+		client:   client,
+		channels: newNotificationChannelStore(defaultNotificationChannelsPath()),
+	}
+}
+
+// listChannels lists the notification channels
+func (n *NotificationChannelTool) listChannels(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	n.channels.mu.Lock()
+	channels, err := n.channels.load()
+	n.channels.mu.Unlock()
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	jsonChannels, err := json.MarshalIndent(channels, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonChannels)), nil
+}
+
+// setChannel creates or replaces a notification channel
+func (n *NotificationChannelTool) setChannel(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	name, ok := args["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Channel name is required"), nil
+	}
+	channel := notificationChannel{Emails: stringsFromArgs(args, "Emails")}
+	if raw, ok := args["SlackDetails"]; ok && raw != nil {
+		slackBytes, err := json.Marshal(raw)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid SlackDetails format"), nil
+		}
+		if err := json.Unmarshal(slackBytes, &channel.Slack); err != nil {
+			return mcp.NewToolResultError("Failed to parse SlackDetails"), nil
+		}
+	}
+	if len(channel.Emails) == 0 && len(channel.Slack) == 0 {
+		return mcp.NewToolResultError("At least one of Emails or SlackDetails is required"), nil
+	}
+
+	n.channels.mu.Lock()
+	defer n.channels.mu.Unlock()
+	channels, err := n.channels.load()
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	channels[name] = channel
+	if err := n.channels.save(channels); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Notification channel %s saved", name)), nil
+}
+
+// deleteChannel deletes a notification channel. Alerts that use it keep their notification settings.
+func (n *NotificationChannelTool) deleteChannel(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	name, ok := req.GetArguments()["Name"].(string)
+	if !ok || name == "" {
+		return mcp.NewToolResultError("Channel name is required"), nil
+	}
+	n.channels.mu.Lock()
+	defer n.channels.mu.Unlock()
+	channels, err := n.channels.load()
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if _, ok := channels[name]; !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown notification channel %q", name)), nil
+	}
+	delete(channels, name)
+	if err := n.channels.save(channels); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	return mcp.NewToolResultText(fmt.Sprintf("Notification channel %s deleted", name)), nil
+}
+
+// rewireChannel moves every alert policy and uptime alert notifying one channel to another channel
+func (n *NotificationChannelTool) rewireChannel(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	fromName, _ := args["From"].(string)
+	toName, _ := args["To"].(string)
+	if fromName == "" || toName == "" {
+		return mcp.NewToolResultError("From and To are required"), nil
+	}
+	if fromName == toName {
+		return mcp.NewToolResultError("From and To must be different channels"), nil
+	}
+	n.channels.mu.Lock()
+	channels, err := n.channels.load()
+	n.channels.mu.Unlock()
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	from, ok := channels[fromName]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown notification channel %q", fromName)), nil
+	}
+	to, ok := channels[toName]
+	if !ok {
+		return mcp.NewToolResultError(fmt.Sprintf("unknown notification channel %q", toName)), nil
+	}
+	dryRun, _ := args["DryRun"].(bool)
+
+	results := []rewireResult{}
+	skipped := []rewireResult{}
+
+	policies, err := listAllPages(func(opt *godo.ListOptions) ([]godo.AlertPolicy, *godo.Response, error) {
+		return n.client.Monitoring.ListAlertPolicies(ctx, opt)
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	for _, policy := range policies {
+		alerts, use := rewireAlerts(policy.Alerts, from, to)
+		if use == channelUnused {
+			continue
+		}
+		result := rewireResult{Kind: "alert-policy", ID: policy.UUID, Name: policy.Description}
+		if use == channelPartial {
+			skipped = append(skipped, result)
+			continue
+		}
+		if !dryRun {
+			enabled := policy.Enabled
+			_, _, err := n.client.Monitoring.UpdateAlertPolicy(ctx, policy.UUID, &godo.AlertPolicyUpdateRequest{
+				Type:        policy.Type,
+				Description: policy.Description,
+				Compare:     policy.Compare,
+				Value:       policy.Value,
+				Window:      policy.Window,
+				Entities:    policy.Entities,
+				Tags:        policy.Tags,
+				Alerts:      alerts,
+				Enabled:     &enabled,
+			})
+			if err != nil {
+				result.Error = err.Error()
+			}
+		}
+		results = append(results, result)
+	}
+
+	checks, err := listAllPages(func(opt *godo.ListOptions) ([]godo.UptimeCheck, *godo.Response, error) {
+		return n.client.UptimeChecks.List(ctx, opt)
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	for _, check := range checks {
+		uptimeAlerts, err := listAllPages(func(opt *godo.ListOptions) ([]godo.UptimeAlert, *godo.Response, error) {
+			return n.client.UptimeChecks.ListAlerts(ctx, check.ID, opt)
+		})
+		if err != nil {
+			results = append(results, rewireResult{Kind: "uptime-alert", CheckID: check.ID, Name: check.Name, Error: err.Error()})
+			continue
+		}
+		for _, alert := range uptimeAlerts {
+			var current godo.Alerts
+			if alert.Notifications != nil {
+				current = godo.Alerts{Email: alert.Notifications.Email, Slack: alert.Notifications.Slack}
+			}
+			rewired, use := rewireAlerts(current, from, to)
+			if use == channelUnused {
+				continue
+			}
+			result := rewireResult{Kind: "uptime-alert", ID: alert.ID, CheckID: check.ID, Name: alert.Name}
+			if use == channelPartial {
+				skipped = append(skipped, result)
+				continue
+			}
+			if !dryRun {
+				_, _, err := n.client.UptimeChecks.UpdateAlert(ctx, check.ID, alert.ID, &godo.UpdateUptimeAlertRequest{
+					Name:          alert.Name,
+					Type:          alert.Type,
+					Threshold:     alert.Threshold,
+					Comparison:    alert.Comparison,
+					Period:        alert.Period,
+					Notifications: &godo.Notifications{Email: rewired.Email, Slack: rewired.Slack},
+				})
+				if err != nil {
+					result.Error = err.Error()
+				}
+			}
+			results = append(results, result)
+		}
+	}
+
+	jsonResults, err := json.MarshalIndent(map[string]any{
+		"from":    fromName,
+		"to":      toName,
+		"dry_run": dryRun,
+		"rewired": results,
+		"skipped": skipped,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResults)), nil
+}
+
+// notificationsFromArgs combines the notification settings given inline with those of the channels named in the
+// Channels argument.
+func notificationsFromArgs(store *notificationChannelStore, args map[string]any, inline godo.Alerts) (godo.Alerts, error) {
+	resolved, err := store.resolve(stringsFromArgs(args, "Channels"))
+	if err != nil {
+		return inline, err
+	}
+	return mergeAlerts(inline, resolved), nil
+}
+
+// Tools returns a list of tool functions
+func (n *NotificationChannelTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: n.listChannels,
+			Tool: mcp.NewTool("notification-channel-list",
+				mcp.WithDescription("List the named notification channels and the email addresses and Slack webhooks they resolve to"),
+			),
+		},
+		{
+			Handler: n.setChannel,
+			Tool: mcp.NewTool("notification-channel-set",
+				mcp.WithDescription("Create or replace a named notification channel. Channels can be referenced by name from the Channels argument of the alert policy and uptime alert tools."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the channel (e.g., 'oncall-slack')")),
+				mcp.WithArray("Emails", mcp.Description("Email addresses to notify"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
+				mcp.WithArray("SlackDetails", mcp.Description("Slack webhooks to notify"),
+					mcp.Items(map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"URL":     map[string]any{"type": "string", "description": "Slack webhook URL"},
+							"Channel": map[string]any{"type": "string", "description": "Slack channel (e.g., '#alerts')"},
+						},
+					})),
+			),
+		},
+		{
+			Handler: n.deleteChannel,
+			Tool: mcp.NewTool("notification-channel-delete",
+				mcp.WithDescription("Delete a named notification channel. Alerts that notify it are not changed."),
+				mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the channel")),
+			),
+		},
+		{
+			Handler: n.rewireChannel,
+			Tool: mcp.NewTool("notification-channel-rewire",
+				mcp.WithDescription("Move every alert policy and uptime alert notifying one channel to another channel. The email addresses and Slack webhooks of the From channel are replaced by those of the To channel; other notification settings are kept. Alerts sharing only some of the From channel targets are reported as skipped and left unchanged."),
+				mcp.WithString("From", mcp.Required(), mcp.Description("Name of the channel to move alerts away from")),
+				mcp.WithString("To", mcp.Required(), mcp.Description("Name of the channel to move alerts to")),
+				mcp.WithBoolean("DryRun", mcp.DefaultBool(false), mcp.Description("Report the alerts that would be rewired without changing them")),
+			),
+		},
+	}
+}
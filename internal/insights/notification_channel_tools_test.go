@@ -0,0 +1,225 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	oncallSlack  = godo.SlackDetails{URL: "https://hooks.slack.com/services/oncall", Channel: "#oncall"}
+	pagerSlack   = godo.SlackDetails{URL: "https://hooks.slack.com/services/pager", Channel: "#pager"}
+	testChannels = map[string]notificationChannel{
+		"oncall-slack": {Emails: []string{"oncall@example.com"}, Slack: []godo.SlackDetails{oncallSlack}},
+		"pager":        {Slack: []godo.SlackDetails{pagerSlack}},
+	}
+)
+
+func setupNotificationChannelStore(t *testing.T) *notificationChannelStore {
+	store := newNotificationChannelStore(filepath.Join(t.TempDir(), "channels", "notification-channels.json"))
+	require.NoError(t, store.save(testChannels))
+	return store
+}
+
+func TestNotificationChannelStore_resolve(t *testing.T) {
+	store := setupNotificationChannelStore(t)
+
+	alerts, err := store.resolve([]string{"oncall-slack", "pager", "oncall-slack"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"oncall@example.com"}, alerts.Email)
+	require.Equal(t, []godo.SlackDetails{oncallSlack, pagerSlack}, alerts.Slack)
+
+	_, err = store.resolve([]string{"missing"})
+	require.Error(t, err)
+
+	// A missing file holds no channels.
+	channels, err := newNotificationChannelStore(filepath.Join(t.TempDir(), "none.json")).load()
+	require.NoError(t, err)
+	require.Empty(t, channels)
+}
+
+func TestRewireAlerts(t *testing.T) {
+	alerts := godo.Alerts{Email: []string{"OnCall@example.com", "dev@example.com"}, Slack: []godo.SlackDetails{oncallSlack}}
+
+	rewired, use := rewireAlerts(alerts, testChannels["oncall-slack"], testChannels["pager"])
+	require.Equal(t, channelUsed, use)
+	require.Equal(t, godo.Alerts{Email: []string{"dev@example.com"}, Slack: []godo.SlackDetails{pagerSlack}}, rewired)
+
+	_, use = rewireAlerts(godo.Alerts{Email: []string{"dev@example.com"}}, testChannels["oncall-slack"], testChannels["pager"])
+	require.Equal(t, channelUnused, use)
+}
+
+func TestRewireAlertsPartialOverlap(t *testing.T) {
+	// The alert notifies another channel that shares the on-call address, but not the on-call Slack webhook.
+	alerts := godo.Alerts{Email: []string{"oncall@example.com", "dev@example.com"}, Slack: []godo.SlackDetails{pagerSlack}}
+
+	rewired, use := rewireAlerts(alerts, testChannels["oncall-slack"], testChannels["pager"])
+	require.Equal(t, channelPartial, use)
+	require.Equal(t, alerts, rewired)
+}
+
+func TestAlertPolicyTool_createAlertPolicyWithChannels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMonitoring := NewMockMonitoringService(ctrl)
+	mockMonitoring.EXPECT().
+		CreateAlertPolicy(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *godo.AlertPolicyCreateRequest) (*godo.AlertPolicy, *godo.Response, error) {
+			require.Equal(t, []string{"dev@example.com", "oncall@example.com"}, req.Alerts.Email)
+			require.Equal(t, []godo.SlackDetails{oncallSlack}, req.Alerts.Slack)
+			return &godo.AlertPolicy{UUID: "policy-1"}, nil, nil
+		}).
+		Times(1)
+	tool := setupAlertPolicyToolWithMock(mockMonitoring)
+	tool.channels = setupNotificationChannelStore(t)
+
+	args := map[string]any{
+		"Type":        "v1/insights/droplet/cpu",
+		"Description": "CPU alert",
+		"Compare":     "GreaterThan",
+		"Value":       float64(80),
+		"Window":      "5m",
+		"Tags":        []any{"production"},
+		"Alerts":      map[string]any{"Email": []any{"dev@example.com"}},
+		"Channels":    []any{"oncall-slack"},
+	}
+	resp, err := tool.createAlertPolicy(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	args["Channels"] = []any{"missing"}
+	resp, err = tool.createAlertPolicy(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestUptimeCheckAlertTool_createUptimeCheckAlertWithChannels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChecks := NewMockUptimeChecksService(ctrl)
+	mockChecks.EXPECT().
+		CreateAlert(gomock.Any(), "check-1", &godo.CreateUptimeAlertRequest{
+			Name:          "down",
+			Type:          "down",
+			Period:        "2m",
+			Comparison:    godo.UptimeAlertLessThan,
+			Notifications: &godo.Notifications{Slack: []godo.SlackDetails{pagerSlack}},
+		}).
+		Return(&godo.UptimeAlert{ID: "alert-1"}, nil, nil).
+		Times(1)
+	tool := setupUptimeAlertToolWithMock(mockChecks)
+	tool.channels = setupNotificationChannelStore(t)
+
+	args := map[string]any{
+		"CheckID":    "check-1",
+		"Name":       "down",
+		"Type":       "down",
+		"Period":     "2m",
+		"Comparison": "less_than",
+		"Channels":   []any{"pager"},
+	}
+	resp, err := tool.createUptimeCheckAlert(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	delete(args, "Channels")
+	resp, err = tool.createUptimeCheckAlert(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: args}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestNotificationChannelTool_rewireChannel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMonitoring := NewMockMonitoringService(ctrl)
+	mockChecks := NewMockUptimeChecksService(ctrl)
+	mockMonitoring.EXPECT().
+		ListAlertPolicies(gomock.Any(), gomock.Any()).
+		Return([]godo.AlertPolicy{
+			{UUID: "policy-1", Type: "v1/insights/droplet/cpu", Value: 80, Enabled: true, Alerts: godo.Alerts{Email: []string{"oncall@example.com"}, Slack: []godo.SlackDetails{oncallSlack}}},
+			{UUID: "policy-2", Alerts: godo.Alerts{Email: []string{"dev@example.com"}}},
+			{UUID: "policy-3", Description: "Shares the on-call address", Alerts: godo.Alerts{Email: []string{"oncall@example.com"}}},
+		}, nil, nil).
+		Times(1)
+	enabled := true
+	mockMonitoring.EXPECT().
+		UpdateAlertPolicy(gomock.Any(), "policy-1", &godo.AlertPolicyUpdateRequest{
+			Type:    "v1/insights/droplet/cpu",
+			Value:   80,
+			Alerts:  godo.Alerts{Slack: []godo.SlackDetails{pagerSlack}},
+			Enabled: &enabled,
+		}).
+		Return(&godo.AlertPolicy{}, nil, nil).
+		Times(1)
+	mockChecks.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return([]godo.UptimeCheck{{ID: "check-1"}}, nil, nil).
+		Times(1)
+	mockChecks.EXPECT().
+		ListAlerts(gomock.Any(), "check-1", gomock.Any()).
+		Return([]godo.UptimeAlert{
+			{ID: "alert-1", Name: "down", Type: "down", Period: "2m", Notifications: &godo.Notifications{Email: []string{"oncall@example.com"}, Slack: []godo.SlackDetails{oncallSlack}}},
+		}, nil, nil).
+		Times(1)
+	mockChecks.EXPECT().
+		UpdateAlert(gomock.Any(), "check-1", "alert-1", &godo.UpdateUptimeAlertRequest{
+			Name:          "down",
+			Type:          "down",
+			Period:        "2m",
+			Notifications: &godo.Notifications{Slack: []godo.SlackDetails{pagerSlack}},
+		}).
+		Return(&godo.UptimeAlert{}, nil, nil).
+		Times(1)
+
+	tool := NewNotificationChannelTool(&godo.Client{Monitoring: mockMonitoring, UptimeChecks: mockChecks})
+	tool.channels = setupNotificationChannelStore(t)
+
+	resp, err := tool.rewireChannel(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"From": "oncall-slack",
+		"To":   "pager",
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var out struct {
+		Rewired []rewireResult `json:"rewired"`
+		Skipped []rewireResult `json:"skipped"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+	require.Len(t, out.Rewired, 2)
+	require.Equal(t, []rewireResult{{Kind: "alert-policy", ID: "policy-3", Name: "Shares the on-call address"}}, out.Skipped)
+
+	resp, err = tool.rewireChannel(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"From": "oncall-slack",
+		"To":   "missing",
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestNotificationChannelTool_setChannel(t *testing.T) {
+	tool := NewNotificationChannelTool(&godo.Client{})
+	tool.channels = newNotificationChannelStore(filepath.Join(t.TempDir(), "notification-channels.json"))
+
+	resp, err := tool.setChannel(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Name":         "oncall-slack",
+		"SlackDetails": []any{map[string]any{"URL": oncallSlack.URL, "Channel": oncallSlack.Channel}},
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	channels, err := tool.channels.load()
+	require.NoError(t, err)
+	require.Equal(t, []godo.SlackDetails{oncallSlack}, channels["oncall-slack"].Slack)
+
+	resp, err = tool.setChannel(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Name": "empty"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -17,13 +17,15 @@ const (
 
 // UptimeCheckAlertTool provides UptimeCheck and Alert management tools
 type UptimeCheckAlertTool struct {
-	client *godo.Client
+	client   *godo.Client
+	channels *notificationChannelStore
 }
 
 // NewUptimeTool creates a new UptimeCheck tool
 func NewUptimeCheckAlertTool(client *godo.Client) *UptimeCheckAlertTool {
 	return &UptimeCheckAlertTool{
-		client: client,
+		client:   client,
+		channels: newNotificationChannelStore(defaultNotificationChannelsPath()),
 	}
 }
 
@@ -114,6 +116,15 @@ func (c *UptimeCheckAlertTool) createUptimeCheckAlert(ctx context.Context, req m
 		}
 	}
 
+	// Add the named notification channels
+	notifications, err := notificationsFromArgs(c.channels, req.GetArguments(), godo.Alerts{Email: emails, Slack: slackDetails})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(notifications.Email) == 0 && len(notifications.Slack) == 0 {
+		return mcp.NewToolResultError("At least one of Emails, SlackDetails or Channels is required"), nil
+	}
+
 	createRequest := &godo.CreateUptimeAlertRequest{
 		Name:       name,
 		Type:       alertType,
@@ -121,8 +132,8 @@ func (c *UptimeCheckAlertTool) createUptimeCheckAlert(ctx context.Context, req m
 		Period:     period,
 		Comparison: godo.UptimeAlertComp(comparison),
 		Notifications: &godo.Notifications{
-			Email: emails,
-			Slack: slackDetails,
+			Email: notifications.Email,
+			Slack: notifications.Slack,
 		},
 	}
 
@@ -180,6 +191,15 @@ func (c *UptimeCheckAlertTool) updateUptimeCheckAlert(ctx context.Context, req m
 		}
 	}
 
+	// Add the named notification channels
+	notifications, err := notificationsFromArgs(c.channels, req.GetArguments(), godo.Alerts{Email: emails, Slack: slackDetails})
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(notifications.Email) == 0 && len(notifications.Slack) == 0 {
+		return mcp.NewToolResultError("At least one of Emails, SlackDetails or Channels is required"), nil
+	}
+
 	updateRequest := &godo.UpdateUptimeAlertRequest{
 		Name:       name,
 		Type:       alertType,
@@ -187,8 +207,8 @@ func (c *UptimeCheckAlertTool) updateUptimeCheckAlert(ctx context.Context, req m
 		Period:     period,
 		Comparison: godo.UptimeAlertComp(comparison),
 		Notifications: &godo.Notifications{
-			Email: emails,
-			Slack: slackDetails,
+			Email: notifications.Email,
+			Slack: notifications.Slack,
 		},
 	}
 
@@ -255,13 +275,12 @@ func (c *UptimeCheckAlertTool) Tools() []server.ServerTool {
 				mcp.WithNumber("Threshold", mcp.Description("The threshold at which the alert will enter a trigger state. The specific threshold is dependent on the alert type")),
 				mcp.WithString("Comparison", mcp.Description("The comparison operator used against the alert's threshold. values : greater_than or less_than")),
 				mcp.WithString("Period", mcp.Required(), mcp.WithStringEnumItems([]string{"2m", "3m", "5m", "10m", "15m", "30m", "1h"}), mcp.Description("Period of time the threshold must be exceeded to trigger the alert")),
-				mcp.WithArray("Emails", mcp.Required(), mcp.Description("email addresses to notify"), mcp.Items(map[string]any{
+				mcp.WithArray("Emails", mcp.Description("email addresses to notify"), mcp.Items(map[string]any{
 					"type":        "string",
 					"description": "email address to notify",
 				})),
 				mcp.WithArray(
 					"SlackDetails",
-					mcp.Required(),
 					mcp.Items(map[string]any{
 						"type": "object",
 						"properties": map[string]any{
@@ -271,6 +290,10 @@ func (c *UptimeCheckAlertTool) Tools() []server.ServerTool {
 					}),
 					mcp.Description("Array of Slack details for the alert"),
 				),
+				mcp.WithArray("Channels", mcp.Description("Names of notification channels to notify in addition to Emails and SlackDetails (see notification-channel-list)"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
 			),
 		},
 		{
@@ -284,13 +307,12 @@ func (c *UptimeCheckAlertTool) Tools() []server.ServerTool {
 				mcp.WithNumber("Threshold", mcp.Description("The threshold at which the alert will enter a trigger state. The specific threshold is dependent on the alert type")),
 				mcp.WithString("Comparison", mcp.Description("The comparison operator used against the alert's threshold. value : greater_than or less_than")),
 				mcp.WithString("Period", mcp.Required(), mcp.WithStringEnumItems([]string{"2m", "3m", "5m", "10m", "15m", "30m", "1h"}), mcp.Description("Period of time the threshold must be exceeded to trigger the alert")),
-				mcp.WithArray("Emails", mcp.Required(), mcp.Description("Email addresses to notify"), mcp.Items(map[string]any{
+				mcp.WithArray("Emails", mcp.Description("Email addresses to notify"), mcp.Items(map[string]any{
 					"type":        "string",
 					"description": "email address to notify",
 				})),
 				mcp.WithArray(
 					"SlackDetails",
-					mcp.Required(),
 					mcp.Items(map[string]any{
 						"type": "object",
 						"properties": map[string]any{
@@ -300,6 +322,10 @@ func (c *UptimeCheckAlertTool) Tools() []server.ServerTool {
 					}),
 					mcp.Description("Array of Slack details for the alert"),
 				),
+				mcp.WithArray("Channels", mcp.Description("Names of notification channels to notify in addition to Emails and SlackDetails (see notification-channel-list)"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
 			),
 		},
 		{
@@ -0,0 +1,333 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const (
+	defaultLatencyThresholdMs = 1000
+	defaultUptimeAlertPeriod  = "2m"
+	maxListPageSize           = 200
+)
+
+// defaultUptimeRegions are all regions uptime checks can run from.
+var defaultUptimeRegions = []string{"us_east", "us_west", "eu_west", "se_asia"}
+
+// uptimeTarget is an endpoint to monitor, discovered from a domain record or an app.
+type uptimeTarget struct {
+	Name   string `json:"name"`
+	Target string `json:"target"`
+	Source string `json:"source"`
+}
+
+// provisionResult reports what was done for a single target.
+type provisionResult struct {
+	uptimeTarget
+	CheckAction   string   `json:"check_action"`
+	CheckID       string   `json:"check_id,omitempty"`
+	AlertsCreated []string `json:"alerts_created,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+// listAllPages calls fetch for every page and returns the items of all pages.
+func listAllPages[T any](fetch func(opt *godo.ListOptions) ([]T, *godo.Response, error)) ([]T, error) {
+	var all []T
+	opt := &godo.ListOptions{Page: 1, PerPage: maxListPageSize}
+	for {
+		items, resp, err := fetch(opt)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if resp == nil || resp.Links == nil || resp.Links.IsLastPage() {
+			break
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+		opt.Page = page + 1
+	}
+	return all, nil
+}
+
+// checkTarget returns the uptime check target of a host for a check type.
+func checkTarget(host, checkType string) string {
+	if checkType == "ping" {
+		return host
+	}
+	return checkType + "://" + host
+}
+
+// normalizeTarget makes targets comparable regardless of case and trailing slashes.
+func normalizeTarget(target string) string {
+	return strings.TrimSuffix(strings.ToLower(target), "/")
+}
+
+// domainRecordTargets returns a target for every A, AAAA and CNAME record of a domain. Wildcard records are skipped
+// since they have no single host to check. When names is not empty only records with these names are used.
+func domainRecordTargets(domain string, records []godo.DomainRecord, names []string, checkType string) []uptimeTarget {
+	wanted := map[string]bool{}
+	for _, n := range names {
+		wanted[n] = true
+	}
+	seen := map[string]bool{}
+	var targets []uptimeTarget
+	for _, r := range records {
+		if r.Type != "A" && r.Type != "AAAA" && r.Type != "CNAME" {
+			continue
+		}
+		if strings.Contains(r.Name, "*") || (len(wanted) > 0 && !wanted[r.Name]) {
+			continue
+		}
+		host := domain
+		if r.Name != "@" && r.Name != "" {
+			host = r.Name + "." + domain
+		}
+		if seen[host] {
+			continue
+		}
+		seen[host] = true
+		targets = append(targets, uptimeTarget{Name: host, Target: checkTarget(host, checkType), Source: "domain-record"})
+	}
+	return targets
+}
+
+// appTargets returns a target for the live URL of every app. When ids is not empty only these apps are used.
+func appTargets(apps []*godo.App, ids []string, checkType string) []uptimeTarget {
+	wanted := map[string]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	var targets []uptimeTarget
+	for _, app := range apps {
+		if app.LiveURL == "" || (len(wanted) > 0 && !wanted[app.ID]) {
+			continue
+		}
+		u, err := url.Parse(app.LiveURL)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		name := u.Host
+		if app.Spec != nil && app.Spec.Name != "" {
+			name = app.Spec.Name
+		}
+		targets = append(targets, uptimeTarget{Name: name, Target: checkTarget(u.Host, checkType), Source: "app"})
+	}
+	return targets
+}
+
+// UptimeProvisionTool provides tools to set up uptime monitoring in bulk
+type UptimeProvisionTool struct {
+	client *godo.Client
+}
+
+// NewUptimeProvisionTool creates a new uptime provisioning tool
+func NewUptimeProvisionTool(client *godo.Client) *UptimeProvisionTool {
+	return &UptimeProvisionTool{
+		client: client,
+	}
+}
+
+// discoverTargets lists the endpoints to monitor from a domain or from apps.
+func (u *UptimeProvisionTool) discoverTargets(ctx context.Context, args map[string]any, source, checkType string) ([]uptimeTarget, error) {
+	if source == "domain" {
+		domain, _ := args["Domain"].(string)
+		records, err := listAllPages(func(opt *godo.ListOptions) ([]godo.DomainRecord, *godo.Response, error) {
+			return u.client.Domains.Records(ctx, domain, opt)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return domainRecordTargets(domain, records, stringsFromArgs(args, "RecordNames"), checkType), nil
+	}
+	apps, err := listAllPages(func(opt *godo.ListOptions) ([]*godo.App, *godo.Response, error) {
+		return u.client.Apps.List(ctx, opt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return appTargets(apps, stringsFromArgs(args, "AppIDs"), checkType), nil
+}
+
+// provisionUptimeChecks creates uptime checks with latency and down alerts for every discovered endpoint, skipping
+// endpoints and alerts that already exist
+func (u *UptimeProvisionTool) provisionUptimeChecks(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	checkType := "https"
+	if v, ok := args["Type"].(string); ok && v != "" {
+		checkType = strings.ToLower(v)
+	}
+	if checkType != "https" && checkType != "http" && checkType != "ping" {
+		return mcp.NewToolResultError("Type must be 'https', 'http' or 'ping'"), nil
+	}
+	regions := stringsFromArgs(args, "Regions")
+	if len(regions) == 0 {
+		regions = defaultUptimeRegions
+	}
+	latencyThreshold := defaultLatencyThresholdMs
+	if v, ok := args["LatencyThresholdMs"].(float64); ok && v > 0 {
+		latencyThreshold = int(v)
+	}
+	period := defaultUptimeAlertPeriod
+	if v, ok := args["Period"].(string); ok && v != "" {
+		period = v
+	}
+	notifications := &godo.Notifications{Email: stringsFromArgs(args, "Emails")}
+	if raw, ok := args["SlackDetails"]; ok && raw != nil {
+		slackBytes, err := json.Marshal(raw)
+		if err != nil {
+			return mcp.NewToolResultError("Invalid SlackDetails format"), nil
+		}
+		if err := json.Unmarshal(slackBytes, &notifications.Slack); err != nil {
+			return mcp.NewToolResultError("Failed to parse SlackDetails"), nil
+		}
+	}
+	source, _ := args["Source"].(string)
+	if source != "domain" && source != "apps" {
+		return mcp.NewToolResultError("Source must be 'domain' or 'apps'"), nil
+	}
+	if domain, _ := args["Domain"].(string); source == "domain" && domain == "" {
+		return mcp.NewToolResultError("Domain is required when Source is 'domain'"), nil
+	}
+	dryRun, _ := args["DryRun"].(bool)
+
+	targets, err := u.discoverTargets(ctx, args, source, checkType)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	checks, err := listAllPages(func(opt *godo.ListOptions) ([]godo.UptimeCheck, *godo.Response, error) {
+		return u.client.UptimeChecks.List(ctx, opt)
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	existing := map[string]godo.UptimeCheck{}
+	for _, c := range checks {
+		existing[normalizeTarget(c.Target)] = c
+	}
+
+	results := make([]provisionResult, 0, len(targets))
+	for _, target := range targets {
+		result := provisionResult{uptimeTarget: target}
+		alertTypes := map[string]bool{}
+
+		if check, ok := existing[normalizeTarget(target.Target)]; ok {
+			result.CheckAction = "exists"
+			result.CheckID = check.ID
+			alerts, err := listAllPages(func(opt *godo.ListOptions) ([]godo.UptimeAlert, *godo.Response, error) {
+				return u.client.UptimeChecks.ListAlerts(ctx, check.ID, opt)
+			})
+			if err != nil {
+				result.Error = err.Error()
+				results = append(results, result)
+				continue
+			}
+			for _, a := range alerts {
+				alertTypes[a.Type] = true
+			}
+		} else {
+			result.CheckAction = "create"
+			if !dryRun {
+				check, _, err := u.client.UptimeChecks.Create(ctx, &godo.CreateUptimeCheckRequest{
+					Name:    target.Name,
+					Type:    checkType,
+					Target:  target.Target,
+					Regions: regions,
+					Enabled: true,
+				})
+				if err != nil {
+					result.Error = err.Error()
+					results = append(results, result)
+					continue
+				}
+				result.CheckID = check.ID
+			}
+		}
+
+		wantedAlerts := []*godo.CreateUptimeAlertRequest{
+			{Name: target.Name + " down", Type: "down", Comparison: godo.UptimeAlertLessThan, Notifications: notifications, Period: period},
+		}
+		if checkType != "ping" {
+			wantedAlerts = append(wantedAlerts, &godo.CreateUptimeAlertRequest{
+				Name: target.Name + " latency", Type: "latency", Threshold: latencyThreshold,
+				Comparison: godo.UptimeAlertGreaterThan, Notifications: notifications, Period: period,
+			})
+		}
+		for _, alert := range wantedAlerts {
+			if alertTypes[alert.Type] {
+				continue
+			}
+			if !dryRun {
+				if _, _, err := u.client.UptimeChecks.CreateAlert(ctx, result.CheckID, alert); err != nil {
+					result.Error = err.Error()
+					break
+				}
+			}
+			result.AlertsCreated = append(result.AlertsCreated, alert.Type)
+		}
+		results = append(results, result)
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Target < results[j].Target })
+
+	jsonResults, err := json.MarshalIndent(map[string]any{
+		"dry_run": dryRun,
+		"results": results,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonResults)), nil
+}
+
+// Tools returns a list of tool functions
+func (u *UptimeProvisionTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: u.provisionUptimeChecks,
+			Tool: mcp.NewTool("uptimecheck-provision",
+				mcp.WithDescription("Create uptime checks with down and latency alerts for every A, AAAA and CNAME record of a domain or for the live URL of apps. Endpoints that already have a check and alerts that already exist are skipped, so it is safe to run repeatedly."),
+				mcp.WithString("Source", mcp.Required(), mcp.Enum("domain", "apps"), mcp.Description("Where to discover endpoints: the records of a domain or App Platform live URLs")),
+				mcp.WithString("Domain", mcp.Description("Domain whose records are monitored (required when Source is 'domain')")),
+				mcp.WithArray("RecordNames", mcp.Description("Only monitor records with these names (e.g., '@', 'www', 'api')"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
+				mcp.WithArray("AppIDs", mcp.Description("Only monitor these apps (default: all apps)"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
+				mcp.WithString("Type", mcp.DefaultString("https"), mcp.Enum("https", "http", "ping"), mcp.Description("Type of the uptime checks")),
+				mcp.WithArray("Regions", mcp.Description("Regions to check from. values : \"us_east\", \"us_west\", \"eu_west\", \"se_asia\" (default: all)"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
+				mcp.WithNumber("LatencyThresholdMs", mcp.DefaultNumber(defaultLatencyThresholdMs), mcp.Description("Latency in milliseconds above which the latency alert triggers")),
+				mcp.WithString("Period", mcp.DefaultString(defaultUptimeAlertPeriod), mcp.WithStringEnumItems([]string{"2m", "3m", "5m", "10m", "15m", "30m", "1h"}), mcp.Description("Period of time the threshold must be exceeded to trigger the alerts")),
+				mcp.WithArray("Emails", mcp.Description("Email addresses to notify"),
+					mcp.Items(map[string]any{
+						"type": "string",
+					})),
+				mcp.WithArray("SlackDetails", mcp.Description("Slack channels to notify"),
+					mcp.Items(map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"URL":     map[string]any{"type": "string", "description": "Slack webhook URL"},
+							"Channel": map[string]any{"type": "string", "description": "Slack channel (e.g., '#alerts')"},
+						},
+					})),
+				mcp.WithBoolean("DryRun", mcp.DefaultBool(false), mcp.Description("Report the checks and alerts that would be created without creating them")),
+			),
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupUptimeProvisionToolWithMock(uptime *MockUptimeChecksService, domains *MockDomainsService, apps *MockAppsService) *UptimeProvisionTool {
+	client := &godo.Client{}
+	client.UptimeChecks = uptime
+	client.Domains = domains
+	client.Apps = apps
+	return NewUptimeProvisionTool(client)
+}
+
+func TestDomainRecordTargets(t *testing.T) {
+	records := []godo.DomainRecord{
+		{Type: "A", Name: "@"},
+		{Type: "AAAA", Name: "@"},
+		{Type: "CNAME", Name: "www"},
+		{Type: "A", Name: "*"},
+		{Type: "MX", Name: "@"},
+		{Type: "TXT", Name: "api"},
+		{Type: "A", Name: "api"},
+	}
+
+	targets := domainRecordTargets("example.com", records, nil, "https")
+	require.Equal(t, []uptimeTarget{
+		{Name: "example.com", Target: "https://example.com", Source: "domain-record"},
+		{Name: "www.example.com", Target: "https://www.example.com", Source: "domain-record"},
+		{Name: "api.example.com", Target: "https://api.example.com", Source: "domain-record"},
+	}, targets)
+
+	targets = domainRecordTargets("example.com", records, []string{"api"}, "ping")
+	require.Equal(t, []uptimeTarget{{Name: "api.example.com", Target: "api.example.com", Source: "domain-record"}}, targets)
+}
+
+func TestAppTargets(t *testing.T) {
+	apps := []*godo.App{
+		{ID: "app-1", LiveURL: "https://web-abc12.ondigitalocean.app", Spec: &godo.AppSpec{Name: "web"}},
+		{ID: "app-2"},
+		{ID: "app-3", LiveURL: "https://shop.example.com"},
+	}
+	require.Equal(t, []uptimeTarget{
+		{Name: "web", Target: "https://web-abc12.ondigitalocean.app", Source: "app"},
+		{Name: "shop.example.com", Target: "https://shop.example.com", Source: "app"},
+	}, appTargets(apps, nil, "https"))
+	require.Len(t, appTargets(apps, []string{"app-3"}, "https"), 1)
+}
+
+func TestUptimeProvisionTool_provisionUptimeChecks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	records := []godo.DomainRecord{{Type: "A", Name: "@"}, {Type: "CNAME", Name: "www"}}
+	notifications := &godo.Notifications{Email: []string{"ops@example.com"}}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockUptimeChecksService, *MockDomainsService, *MockAppsService)
+		expectError bool
+		expected    map[string]provisionResult
+	}{
+		{
+			name: "Create missing check and alerts",
+			args: map[string]any{
+				"Source": "domain",
+				"Domain": "example.com",
+				"Emails": []any{"ops@example.com"},
+			},
+			mockSetup: func(u *MockUptimeChecksService, d *MockDomainsService, _ *MockAppsService) {
+				d.EXPECT().
+					Records(gomock.Any(), "example.com", gomock.Any()).
+					Return(records, nil, nil).
+					Times(1)
+				u.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return([]godo.UptimeCheck{{ID: "check-1", Target: "https://Example.com/"}}, nil, nil).
+					Times(1)
+				// The existing check already has a down alert.
+				u.EXPECT().
+					ListAlerts(gomock.Any(), "check-1", gomock.Any()).
+					Return([]godo.UptimeAlert{{ID: "alert-1", Type: "down"}}, nil, nil).
+					Times(1)
+				u.EXPECT().
+					CreateAlert(gomock.Any(), "check-1", &godo.CreateUptimeAlertRequest{
+						Name: "example.com latency", Type: "latency", Threshold: 1000,
+						Comparison: godo.UptimeAlertGreaterThan, Notifications: notifications, Period: "2m",
+					}).
+					Return(&godo.UptimeAlert{ID: "alert-2"}, nil, nil).
+					Times(1)
+				u.EXPECT().
+					Create(gomock.Any(), &godo.CreateUptimeCheckRequest{
+						Name:    "www.example.com",
+						Type:    "https",
+						Target:  "https://www.example.com",
+						Regions: []string{"us_east", "us_west", "eu_west", "se_asia"},
+						Enabled: true,
+					}).
+					Return(&godo.UptimeCheck{ID: "check-2"}, nil, nil).
+					Times(1)
+				u.EXPECT().
+					CreateAlert(gomock.Any(), "check-2", gomock.Any()).
+					Return(&godo.UptimeAlert{}, nil, nil).
+					Times(2)
+			},
+			expected: map[string]provisionResult{
+				"https://example.com":     {CheckAction: "exists", CheckID: "check-1", AlertsCreated: []string{"latency"}},
+				"https://www.example.com": {CheckAction: "create", CheckID: "check-2", AlertsCreated: []string{"down", "latency"}},
+			},
+		},
+		{
+			name: "Dry run from apps",
+			args: map[string]any{"Source": "apps", "DryRun": true},
+			mockSetup: func(u *MockUptimeChecksService, _ *MockDomainsService, a *MockAppsService) {
+				a.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return([]*godo.App{{ID: "app-1", LiveURL: "https://web.example.com"}}, nil, nil).
+					Times(1)
+				u.EXPECT().
+					List(gomock.Any(), gomock.Any()).
+					Return(nil, nil, nil).
+					Times(1)
+			},
+			expected: map[string]provisionResult{
+				"https://web.example.com": {CheckAction: "create", AlertsCreated: []string{"down", "latency"}},
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{"Source": "domain", "Domain": "example.com"},
+			mockSetup: func(_ *MockUptimeChecksService, d *MockDomainsService, _ *MockAppsService) {
+				d.EXPECT().
+					Records(gomock.Any(), "example.com", gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing domain",
+			args:        map[string]any{"Source": "domain"},
+			expectError: true,
+		},
+		{
+			name:        "Invalid source",
+			args:        map[string]any{"Source": "load-balancers"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockUptime := NewMockUptimeChecksService(ctrl)
+			mockDomains := NewMockDomainsService(ctrl)
+			mockApps := NewMockAppsService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockUptime, mockDomains, mockApps)
+			}
+			tool := setupUptimeProvisionToolWithMock(mockUptime, mockDomains, mockApps)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.provisionUptimeChecks(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, resp.IsError)
+
+			var out struct {
+				Results []provisionResult `json:"results"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &out))
+			require.Len(t, out.Results, len(tc.expected))
+			for _, r := range out.Results {
+				expected, ok := tc.expected[r.Target]
+				require.True(t, ok, r.Target)
+				require.Empty(t, r.Error)
+				require.Equal(t, expected.CheckAction, r.CheckAction)
+				require.Equal(t, expected.CheckID, r.CheckID)
+				require.Equal(t, expected.AlertsCreated, r.AlertsCreated)
+			}
+		})
+	}
+}
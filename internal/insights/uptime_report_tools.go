@@ -0,0 +1,273 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+const defaultSLOTarget = 99.9
+
+// regionAvailability is the state of a check in a single region.
+type regionAvailability struct {
+	Region       string  `json:"region"`
+	Status       string  `json:"status"`
+	Availability float64 `json:"availability"`
+}
+
+// checkAvailability is the availability of a single check over the last 30 days.
+type checkAvailability struct {
+	ID             string                     `json:"id"`
+	Name           string                     `json:"name"`
+	Target         string                     `json:"target"`
+	Status         string                     `json:"status"`
+	Availability   float64                    `json:"availability"`
+	WorstRegion    string                     `json:"worst_region,omitempty"`
+	Regions        []regionAvailability       `json:"regions"`
+	PreviousOutage *godo.UptimePreviousOutage `json:"previous_outage,omitempty"`
+	Breach         bool                       `json:"breach"`
+	Error          string                     `json:"error,omitempty"`
+}
+
+// groupAvailability aggregates the availability of the checks of a group.
+type groupAvailability struct {
+	Name         string   `json:"name"`
+	Checks       int      `json:"checks"`
+	Availability float64  `json:"availability"`
+	Lowest       float64  `json:"lowest"`
+	Breaches     []string `json:"breaches"`
+	Breach       bool     `json:"breach"`
+}
+
+// slaReport is the output of the SLA report tool.
+type slaReport struct {
+	Target  float64              `json:"target"`
+	Overall *groupAvailability   `json:"overall"`
+	Groups  []*groupAvailability `json:"groups,omitempty"`
+	Checks  []*checkAvailability `json:"checks"`
+}
+
+// roundAvailability rounds an availability percentage to three decimals, enough to tell 99.9% from 99.95%.
+func roundAvailability(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
+
+// checkAvailabilityFromState computes the availability of a check as the mean 30-day uptime of its regions.
+func checkAvailabilityFromState(check godo.UptimeCheck, state *godo.UptimeCheckState, target float64) *checkAvailability {
+	result := &checkAvailability{ID: check.ID, Name: check.Name, Target: check.Target, Status: "up"}
+	if !check.Enabled {
+		result.Status = "disabled"
+	}
+	regions := make([]string, 0, len(state.Regions))
+	for region := range state.Regions {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	sum := 0.0
+	worst := math.Inf(1)
+	for _, region := range regions {
+		r := state.Regions[region]
+		availability := roundAvailability(float64(r.ThirtyDayUptimePercentage))
+		result.Regions = append(result.Regions, regionAvailability{Region: region, Status: r.Status, Availability: availability})
+		sum += availability
+		if availability < worst {
+			worst = availability
+			result.WorstRegion = region
+		}
+		if r.Status == "down" && check.Enabled {
+			result.Status = "down"
+		}
+	}
+	if len(regions) > 0 {
+		result.Availability = roundAvailability(sum / float64(len(regions)))
+		result.Breach = result.Availability < target
+	}
+	if state.PreviousOutage.StartedAt != "" {
+		outage := state.PreviousOutage
+		result.PreviousOutage = &outage
+	}
+	return result
+}
+
+// aggregateAvailability summarizes a set of checks. Checks without state are left out.
+func aggregateAvailability(name string, checks []*checkAvailability, target float64) *groupAvailability {
+	group := &groupAvailability{Name: name, Breaches: []string{}}
+	sum := 0.0
+	for _, c := range checks {
+		if c.Error != "" || len(c.Regions) == 0 {
+			continue
+		}
+		if group.Checks == 0 || c.Availability < group.Lowest {
+			group.Lowest = c.Availability
+		}
+		group.Checks++
+		sum += c.Availability
+		if c.Breach {
+			group.Breaches = append(group.Breaches, c.Name)
+		}
+	}
+	if group.Checks > 0 {
+		group.Availability = roundAvailability(sum / float64(group.Checks))
+		group.Breach = group.Availability < target
+	}
+	return group
+}
+
+// groupMatches reports whether a check belongs to a group, by ID, name or a substring of its target.
+func groupMatches(c *checkAvailability, members []string) bool {
+	for _, m := range members {
+		if m == c.ID || m == c.Name || (m != "" && strings.Contains(c.Target, m)) {
+			return true
+		}
+	}
+	return false
+}
+
+// renderSLAMarkdown renders an SLA report as Markdown tables.
+func renderSLAMarkdown(report *slaReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Uptime SLA report\n\nTarget: %.3f%% availability over the last 30 days\n\n", report.Target)
+	fmt.Fprintf(&b, "Overall: %.3f%% across %d checks, lowest %.3f%%, %d in breach\n\n",
+		report.Overall.Availability, report.Overall.Checks, report.Overall.Lowest, len(report.Overall.Breaches))
+
+	if len(report.Groups) > 0 {
+		b.WriteString("## Groups\n\n| Group | Checks | Availability | Lowest | SLO |\n|---|---|---|---|---|\n")
+		for _, g := range report.Groups {
+			fmt.Fprintf(&b, "| %s | %d | %.3f%% | %.3f%% | %s |\n", g.Name, g.Checks, g.Availability, g.Lowest, sloFlag(g.Breach))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Checks\n\n| Check | Target | Status | Availability | Worst region | SLO |\n|---|---|---|---|---|---|\n")
+	for _, c := range report.Checks {
+		if c.Error != "" {
+			fmt.Fprintf(&b, "| %s | %s | error: %s | | | |\n", c.Name, c.Target, c.Error)
+			continue
+		}
+		worst := ""
+		for _, r := range c.Regions {
+			if r.Region == c.WorstRegion {
+				worst = fmt.Sprintf("%s (%.3f%%)", r.Region, r.Availability)
+			}
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %.3f%% | %s | %s |\n", c.Name, c.Target, c.Status, c.Availability, worst, sloFlag(c.Breach))
+	}
+	return b.String()
+}
+
+// sloFlag renders a breach flag.
+func sloFlag(breach bool) string {
+	if breach {
+		return "BREACH"
+	}
+	return "ok"
+}
+
+// UptimeReportTool provides uptime SLA reporting tools
+type UptimeReportTool struct {
+	client *godo.Client
+}
+
+// NewUptimeReportTool creates a new uptime SLA report tool
+func NewUptimeReportTool(client *godo.Client) *UptimeReportTool {
+	return &UptimeReportTool{
+		client: client,
+	}
+}
+
+// getSLAReport reports the 30-day availability of all uptime checks against an SLO target
+func (u *UptimeReportTool) getSLAReport(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	target := defaultSLOTarget
+	if v, ok := args["Target"].(float64); ok {
+		target = v
+	}
+	if target <= 0 || target > 100 {
+		return mcp.NewToolResultError("Target must be a percentage between 0 and 100"), nil
+	}
+	format := "json"
+	if v, ok := args["Format"].(string); ok && v != "" {
+		format = v
+	}
+	if format != "json" && format != "markdown" {
+		return mcp.NewToolResultError("Format must be 'json' or 'markdown'"), nil
+	}
+	includeDisabled, _ := args["IncludeDisabled"].(bool)
+	groups := map[string][]string{}
+	if rawGroups, ok := args["Groups"].(map[string]any); ok {
+		for name := range rawGroups {
+			groups[name] = stringsFromArgs(rawGroups, name)
+		}
+	}
+
+	checks, err := listAllPages(func(opt *godo.ListOptions) ([]godo.UptimeCheck, *godo.Response, error) {
+		return u.client.UptimeChecks.List(ctx, opt)
+	})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+
+	report := &slaReport{Target: target, Checks: []*checkAvailability{}}
+	for _, check := range checks {
+		if !check.Enabled && !includeDisabled {
+			continue
+		}
+		state, _, err := u.client.UptimeChecks.GetState(ctx, check.ID)
+		if err != nil {
+			report.Checks = append(report.Checks, &checkAvailability{ID: check.ID, Name: check.Name, Target: check.Target, Error: err.Error()})
+			continue
+		}
+		report.Checks = append(report.Checks, checkAvailabilityFromState(check, state, target))
+	}
+	// Lowest availability first, so the checks needing attention lead the report.
+	sort.SliceStable(report.Checks, func(i, j int) bool { return report.Checks[i].Availability < report.Checks[j].Availability })
+
+	report.Overall = aggregateAvailability("overall", report.Checks, target)
+	groupNames := make([]string, 0, len(groups))
+	for name := range groups {
+		groupNames = append(groupNames, name)
+	}
+	sort.Strings(groupNames)
+	for _, name := range groupNames {
+		var members []*checkAvailability
+		for _, c := range report.Checks {
+			if groupMatches(c, groups[name]) {
+				members = append(members, c)
+			}
+		}
+		report.Groups = append(report.Groups, aggregateAvailability(name, members, target))
+	}
+
+	if format == "markdown" {
+		return mcp.NewToolResultText(renderSLAMarkdown(report)), nil
+	}
+	jsonReport, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonReport)), nil
+}
+
+// Tools returns a list of tool functions
+func (u *UptimeReportTool) Tools() []server.ServerTool {
+	return []server.ServerTool{
+		{
+			Handler: u.getSLAReport,
+			Tool: mcp.NewTool("uptimecheck-sla-report",
+				mcp.WithDescription("Report the 30-day availability of all uptime checks per check, per region and per group, flagging checks and groups below an SLO target"),
+				mcp.WithNumber("Target", mcp.DefaultNumber(defaultSLOTarget), mcp.Description("SLO target as an availability percentage (e.g., 99.9)")),
+				mcp.WithObject("Groups", mcp.Description("Groups to aggregate, mapping a group name to check IDs, check names or target substrings (e.g., {\"production\": [\"example.com\"]})")),
+				mcp.WithString("Format", mcp.DefaultString("json"), mcp.Enum("json", "markdown"), mcp.Description("Output format of the report")),
+				mcp.WithBoolean("IncludeDisabled", mcp.DefaultBool(false), mcp.Description("Include disabled checks")),
+			),
+		},
+	}
+}
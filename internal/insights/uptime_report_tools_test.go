@@ -0,0 +1,94 @@
+package insights
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckAvailabilityFromState(t *testing.T) {
+	check := godo.UptimeCheck{ID: "check-1", Name: "web", Target: "https://example.com", Enabled: true}
+	state := &godo.UptimeCheckState{
+		Regions: map[string]godo.UptimeRegion{
+			"us_east": {Status: "up", ThirtyDayUptimePercentage: 100},
+			"eu_west": {Status: "down", ThirtyDayUptimePercentage: 99.7},
+		},
+		PreviousOutage: godo.UptimePreviousOutage{Region: "eu_west", StartedAt: "2025-01-02T03:00:00Z", DurationSeconds: 600},
+	}
+
+	result := checkAvailabilityFromState(check, state, 99.9)
+	require.Equal(t, "down", result.Status)
+	require.Equal(t, 99.85, result.Availability)
+	require.Equal(t, "eu_west", result.WorstRegion)
+	require.True(t, result.Breach)
+	require.NotNil(t, result.PreviousOutage)
+	require.False(t, checkAvailabilityFromState(check, state, 99.5).Breach)
+}
+
+func TestUptimeReportTool_getSLAReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUptime := NewMockUptimeChecksService(ctrl)
+	mockUptime.EXPECT().
+		List(gomock.Any(), gomock.Any()).
+		Return([]godo.UptimeCheck{
+			{ID: "check-1", Name: "web", Target: "https://example.com", Enabled: true},
+			{ID: "check-2", Name: "api", Target: "https://api.example.com", Enabled: true},
+			{ID: "check-3", Name: "old", Target: "https://old.example.org", Enabled: false},
+			{ID: "check-4", Name: "shop", Target: "https://shop.example.org", Enabled: true},
+		}, nil, nil).
+		Times(2)
+	mockUptime.EXPECT().
+		GetState(gomock.Any(), "check-1").
+		Return(&godo.UptimeCheckState{Regions: map[string]godo.UptimeRegion{"us_east": {Status: "up", ThirtyDayUptimePercentage: 100}}}, nil, nil).
+		Times(2)
+	mockUptime.EXPECT().
+		GetState(gomock.Any(), "check-2").
+		Return(&godo.UptimeCheckState{Regions: map[string]godo.UptimeRegion{"us_east": {Status: "up", ThirtyDayUptimePercentage: 99.5}}}, nil, nil).
+		Times(2)
+	mockUptime.EXPECT().
+		GetState(gomock.Any(), "check-4").
+		Return(nil, nil, errors.New("api error")).
+		Times(2)
+	tool := NewUptimeReportTool(&godo.Client{UptimeChecks: mockUptime})
+
+	resp, err := tool.getSLAReport(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Groups": map[string]any{"example.com": []any{"example.com"}, "web": []any{"check-1"}},
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	var report slaReport
+	require.NoError(t, json.Unmarshal([]byte(resp.Content[0].(mcp.TextContent).Text), &report))
+	require.Len(t, report.Checks, 3)
+	require.Equal(t, "check-4", report.Checks[0].ID)
+	require.NotEmpty(t, report.Checks[0].Error)
+	require.Equal(t, 2, report.Overall.Checks)
+	require.Equal(t, 99.75, report.Overall.Availability)
+	require.Equal(t, []string{"api"}, report.Overall.Breaches)
+	require.Len(t, report.Groups, 2)
+	require.Equal(t, "example.com", report.Groups[0].Name)
+	require.True(t, report.Groups[0].Breach)
+	require.Equal(t, "web", report.Groups[1].Name)
+	require.False(t, report.Groups[1].Breach)
+
+	resp, err = tool.getSLAReport(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"Format": "markdown",
+		"Target": float64(99),
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	text := resp.Content[0].(mcp.TextContent).Text
+	require.Contains(t, text, "| api | https://api.example.com | up | 99.500% | us_east (99.500%) | ok |")
+	require.NotContains(t, text, "BREACH")
+
+	resp, err = tool.getSLAReport(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"Target": float64(120)}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
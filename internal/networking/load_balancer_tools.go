@@ -0,0 +1,658 @@
+package networking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// forwardingRuleSchema describes a single load balancer forwarding rule.
+var forwardingRuleSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"EntryProtocol": map[string]any{
+			"type":        "string",
+			"description": "Protocol for traffic to the load balancer (http, https, http2, http3, tcp, udp)",
+		},
+		"EntryPort": map[string]any{
+			"type":        "number",
+			"description": "Port the load balancer listens on",
+		},
+		"TargetProtocol": map[string]any{
+			"type":        "string",
+			"description": "Protocol for traffic from the load balancer to the droplets (http, https, http2, tcp, udp)",
+		},
+		"TargetPort": map[string]any{
+			"type":        "number",
+			"description": "Port on the droplets that traffic is sent to",
+		},
+		"CertificateID": map[string]any{
+			"type":        "string",
+			"description": "ID of the TLS certificate used for SSL termination (see certificate-list)",
+		},
+		"TlsPassthrough": map[string]any{
+			"type":        "boolean",
+			"description": "Pass encrypted traffic through to the droplets instead of terminating it",
+		},
+	},
+	"required":    []string{"EntryProtocol", "EntryPort", "TargetProtocol", "TargetPort"},
+	"description": "Load balancer forwarding rule",
+}
+
+// healthCheckSchema describes the load balancer health check.
+var healthCheckSchema = map[string]any{
+	"Protocol":               map[string]any{"type": "string", "description": "Protocol used for health checks (http, https, tcp)"},
+	"Port":                   map[string]any{"type": "number", "description": "Port on the droplets to check"},
+	"Path":                   map[string]any{"type": "string", "description": "Path for http and https health checks"},
+	"CheckIntervalSeconds":   map[string]any{"type": "number", "description": "Seconds between two consecutive checks"},
+	"ResponseTimeoutSeconds": map[string]any{"type": "number", "description": "Seconds to wait for a response before marking the check as failed"},
+	"HealthyThreshold":       map[string]any{"type": "number", "description": "Consecutive successes before a droplet is marked healthy"},
+	"UnhealthyThreshold":     map[string]any{"type": "number", "description": "Consecutive failures before a droplet is marked unhealthy"},
+}
+
+// stickySessionsSchema describes the load balancer session affinity settings.
+var stickySessionsSchema = map[string]any{
+	"Type":             map[string]any{"type": "string", "description": "Sticky sessions type: 'cookies' or 'none'"},
+	"CookieName":       map[string]any{"type": "string", "description": "Name of the cookie, when Type is 'cookies'"},
+	"CookieTtlSeconds": map[string]any{"type": "number", "description": "Lifetime of the cookie in seconds, when Type is 'cookies'"},
+}
+
+// domainSchema describes a domain served by a global load balancer.
+var domainSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"Name":          map[string]any{"type": "string", "description": "Fully qualified domain name"},
+		"IsManaged":     map[string]any{"type": "boolean", "description": "Whether the domain is managed by DigitalOcean DNS"},
+		"CertificateID": map[string]any{"type": "string", "description": "ID of the TLS certificate for the domain"},
+	},
+	"required":    []string{"Name"},
+	"description": "Domain served by a global load balancer",
+}
+
+// glbSettingsSchema describes the settings of a global load balancer.
+var glbSettingsSchema = map[string]any{
+	"TargetProtocol":    map[string]any{"type": "string", "description": "Protocol used to reach the targets (http, https)"},
+	"TargetPort":        map[string]any{"type": "number", "description": "Port used to reach the targets"},
+	"CDN":               map[string]any{"type": "boolean", "description": "Enable CDN caching"},
+	"RegionPriorities":  map[string]any{"type": "object", "description": "Region slug to priority for active-passive failover (e.g., {\"nyc1\": 1, \"ams3\": 2})"},
+	"FailoverThreshold": map[string]any{"type": "number", "description": "Percentage of unhealthy targets that triggers failover to the next region"},
+}
+
+// LoadBalancerTool provides load balancer management tools
+type LoadBalancerTool struct {
+	client *godo.Client
+}
+
+// NewLoadBalancerTool creates a new load balancer tool
+func NewLoadBalancerTool(client *godo.Client) *LoadBalancerTool {
+	return &LoadBalancerTool{
+		client: client,
+	}
+}
+
+// parseDropletIDs converts a list of numbers to droplet IDs
+func parseDropletIDs(raw []any) []int {
+	ids := make([]int, 0, len(raw))
+	for _, v := range raw {
+		if id, ok := v.(float64); ok {
+			ids = append(ids, int(id))
+		}
+	}
+	return ids
+}
+
+// parseStrings converts a list of strings, skipping empty values
+func parseStrings(raw []any) []string {
+	out := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok && s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// parseForwardingRules converts the ForwardingRules argument to godo forwarding rules
+func parseForwardingRules(raw []any) ([]godo.ForwardingRule, error) {
+	rules := make([]godo.ForwardingRule, 0, len(raw))
+	for i, v := range raw {
+		rule, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("forwarding rule %d must be an object", i)
+		}
+		entryProtocol, _ := rule["EntryProtocol"].(string)
+		entryPort, _ := rule["EntryPort"].(float64)
+		targetProtocol, _ := rule["TargetProtocol"].(string)
+		targetPort, _ := rule["TargetPort"].(float64)
+		if entryProtocol == "" || entryPort == 0 || targetProtocol == "" || targetPort == 0 {
+			return nil, fmt.Errorf("forwarding rule %d requires EntryProtocol, EntryPort, TargetProtocol and TargetPort", i)
+		}
+		certificateID, _ := rule["CertificateID"].(string)
+		tlsPassthrough, _ := rule["TlsPassthrough"].(bool)
+		if certificateID != "" && tlsPassthrough {
+			return nil, fmt.Errorf("forwarding rule %d cannot use both CertificateID and TlsPassthrough", i)
+		}
+		rules = append(rules, godo.ForwardingRule{
+			EntryProtocol:  strings.ToLower(entryProtocol),
+			EntryPort:      int(entryPort),
+			TargetProtocol: strings.ToLower(targetProtocol),
+			TargetPort:     int(targetPort),
+			CertificateID:  certificateID,
+			TlsPassthrough: tlsPassthrough,
+		})
+	}
+	return rules, nil
+}
+
+// parseHealthCheck merges the HealthCheck argument into a copy of the current health check, which may be nil
+func parseHealthCheck(current *godo.HealthCheck, raw map[string]any) *godo.HealthCheck {
+	hc := &godo.HealthCheck{}
+	if current != nil {
+		*hc = *current
+	}
+	if v, ok := raw["Protocol"].(string); ok {
+		hc.Protocol = v
+	}
+	if v, ok := raw["Path"].(string); ok {
+		hc.Path = v
+	}
+	if v, ok := raw["Port"].(float64); ok {
+		hc.Port = int(v)
+	}
+	if v, ok := raw["CheckIntervalSeconds"].(float64); ok {
+		hc.CheckIntervalSeconds = int(v)
+	}
+	if v, ok := raw["ResponseTimeoutSeconds"].(float64); ok {
+		hc.ResponseTimeoutSeconds = int(v)
+	}
+	if v, ok := raw["HealthyThreshold"].(float64); ok {
+		hc.HealthyThreshold = int(v)
+	}
+	if v, ok := raw["UnhealthyThreshold"].(float64); ok {
+		hc.UnhealthyThreshold = int(v)
+	}
+	return hc
+}
+
+// parseStickySessions merges the StickySessions argument into a copy of the current sticky sessions, which may be nil
+func parseStickySessions(current *godo.StickySessions, raw map[string]any) *godo.StickySessions {
+	ss := &godo.StickySessions{}
+	if current != nil {
+		*ss = *current
+	}
+	if v, ok := raw["Type"].(string); ok {
+		ss.Type = v
+	}
+	if v, ok := raw["CookieName"].(string); ok {
+		ss.CookieName = v
+	}
+	if v, ok := raw["CookieTtlSeconds"].(float64); ok {
+		ss.CookieTtlSeconds = int(v)
+	}
+	return ss
+}
+
+// parseDomains converts the Domains argument to global load balancer domains
+func parseDomains(raw []any) ([]*godo.LBDomain, error) {
+	domains := make([]*godo.LBDomain, 0, len(raw))
+	for i, v := range raw {
+		d, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("domain %d must be an object", i)
+		}
+		name, _ := d["Name"].(string)
+		if name == "" {
+			return nil, fmt.Errorf("domain %d requires a Name", i)
+		}
+		isManaged, _ := d["IsManaged"].(bool)
+		certificateID, _ := d["CertificateID"].(string)
+		domains = append(domains, &godo.LBDomain{Name: name, IsManaged: isManaged, CertificateID: certificateID})
+	}
+	return domains, nil
+}
+
+// parseGLBSettings merges the GLBSettings argument into a copy of the current global load balancer settings,
+// which may be nil. RegionPriorities replaces the current priorities as a whole.
+func parseGLBSettings(current *godo.GLBSettings, raw map[string]any) *godo.GLBSettings {
+	settings := &godo.GLBSettings{}
+	if current != nil {
+		*settings = *current
+	}
+	if v, ok := raw["TargetProtocol"].(string); ok {
+		settings.TargetProtocol = v
+	}
+	if v, ok := raw["TargetPort"].(float64); ok {
+		settings.TargetPort = uint32(v)
+	}
+	if v, ok := raw["CDN"].(bool); ok {
+		settings.CDN = &godo.CDNSettings{IsEnabled: v}
+	}
+	if v, ok := raw["RegionPriorities"].(map[string]any); ok {
+		settings.RegionPriorities = make(map[string]uint32, len(v))
+		for region, priority := range v {
+			if p, ok := priority.(float64); ok {
+				settings.RegionPriorities[region] = uint32(p)
+			}
+		}
+	}
+	if v, ok := raw["FailoverThreshold"].(float64); ok {
+		settings.FailoverThreshold = uint32(v)
+	}
+	return settings
+}
+
+// applyLoadBalancerArgs sets the provided arguments on a load balancer request, leaving the others unchanged
+func applyLoadBalancerArgs(lbr *godo.LoadBalancerRequest, args map[string]any) error {
+	if v, ok := args["Name"].(string); ok && v != "" {
+		lbr.Name = v
+	}
+	if v, ok := args["Region"].(string); ok && v != "" {
+		lbr.Region = v
+	}
+	if v, ok := args["Type"].(string); ok && v != "" {
+		lbr.Type = strings.ToUpper(v)
+	}
+	if v, ok := args["SizeUnit"].(float64); ok && v > 0 {
+		lbr.SizeUnit = uint32(v)
+		lbr.SizeSlug = ""
+	}
+	if v, ok := args["VPCUUID"].(string); ok && v != "" {
+		lbr.VPCUUID = v
+	}
+	if v, ok := args["ProjectID"].(string); ok && v != "" {
+		lbr.ProjectID = v
+	}
+	if v, ok := args["ForwardingRules"].([]any); ok {
+		rules, err := parseForwardingRules(v)
+		if err != nil {
+			return err
+		}
+		lbr.ForwardingRules = rules
+	}
+	if v, ok := args["HealthCheck"].(map[string]any); ok {
+		lbr.HealthCheck = parseHealthCheck(lbr.HealthCheck, v)
+	}
+	if v, ok := args["StickySessions"].(map[string]any); ok {
+		lbr.StickySessions = parseStickySessions(lbr.StickySessions, v)
+	}
+	if v, ok := args["DropletIDs"].([]any); ok {
+		lbr.DropletIDs = parseDropletIDs(v)
+	}
+	if v, ok := args["Tag"].(string); ok {
+		lbr.Tag = v
+	}
+	if v, ok := args["RedirectHttpToHttps"].(bool); ok {
+		lbr.RedirectHttpToHttps = v
+	}
+	if v, ok := args["EnableProxyProtocol"].(bool); ok {
+		lbr.EnableProxyProtocol = v
+	}
+	if v, ok := args["EnableBackendKeepalive"].(bool); ok {
+		lbr.EnableBackendKeepalive = v
+	}
+	if v, ok := args["Domains"].([]any); ok {
+		domains, err := parseDomains(v)
+		if err != nil {
+			return err
+		}
+		lbr.Domains = domains
+	}
+	if v, ok := args["GLBSettings"].(map[string]any); ok {
+		lbr.GLBSettings = parseGLBSettings(lbr.GLBSettings, v)
+	}
+	if v, ok := args["TargetLoadBalancerIDs"].([]any); ok {
+		lbr.TargetLoadBalancerIDs = parseStrings(v)
+	}
+	if len(lbr.DropletIDs) > 0 && lbr.Tag != "" {
+		return fmt.Errorf("DropletIDs and Tag are mutually exclusive")
+	}
+	return nil
+}
+
+// getLoadBalancer fetches load balancer information by ID
+func (l *LoadBalancerTool) getLoadBalancer(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+	lb, _, err := l.client.LoadBalancers.Get(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonLB, err := json.MarshalIndent(lb, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonLB)), nil
+}
+
+// listLoadBalancers lists load balancers with pagination support
+func (l *LoadBalancerTool) listLoadBalancers(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	page := 1
+	perPage := 20
+	if v, ok := req.GetArguments()["Page"].(float64); ok && int(v) > 0 {
+		page = int(v)
+	}
+	if v, ok := req.GetArguments()["PerPage"].(float64); ok && int(v) > 0 {
+		perPage = int(v)
+	}
+	lbs, _, err := l.client.LoadBalancers.List(ctx, &godo.ListOptions{Page: page, PerPage: perPage})
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonLBs, err := json.MarshalIndent(lbs, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonLBs)), nil
+}
+
+// createLoadBalancer creates a new regional or global load balancer
+func (l *LoadBalancerTool) createLoadBalancer(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	lbr := &godo.LoadBalancerRequest{}
+	if err := applyLoadBalancerArgs(lbr, args); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if v, ok := args["Tags"].([]any); ok {
+		lbr.Tags = parseStrings(v)
+	}
+
+	if lbr.Name == "" {
+		return mcp.NewToolResultError("Load balancer name is required"), nil
+	}
+	if lbr.Type == godo.LoadBalancerTypeGlobal {
+		if len(lbr.Domains) == 0 || lbr.GLBSettings == nil {
+			return mcp.NewToolResultError("Global load balancers require Domains and GLBSettings"), nil
+		}
+	} else {
+		if lbr.Region == "" {
+			return mcp.NewToolResultError("Region is required for regional load balancers"), nil
+		}
+		if len(lbr.ForwardingRules) == 0 {
+			return mcp.NewToolResultError("At least one forwarding rule is required for regional load balancers"), nil
+		}
+	}
+
+	lb, _, err := l.client.LoadBalancers.Create(ctx, lbr)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonLB, err := json.MarshalIndent(lb, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonLB)), nil
+}
+
+// updateLoadBalancer updates a load balancer. The update replaces the whole configuration, so the current
+// configuration is fetched first and only the provided arguments are changed.
+func (l *LoadBalancerTool) updateLoadBalancer(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	args := req.GetArguments()
+	id, ok := args["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+
+	current, _, err := l.client.LoadBalancers.Get(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	lbr := current.AsRequest()
+	// A tag and droplet IDs are mutually exclusive; droplets attached via a tag are reported in DropletIDs too.
+	if lbr.Tag != "" {
+		lbr.DropletIDs = nil
+	}
+	if _, ok := args["DropletIDs"].([]any); ok {
+		lbr.Tag = ""
+	}
+	if v, ok := args["Tag"].(string); ok && v != "" {
+		lbr.DropletIDs = nil
+	}
+	if err := applyLoadBalancerArgs(lbr, args); err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+
+	lb, _, err := l.client.LoadBalancers.Update(ctx, id, lbr)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	jsonLB, err := json.MarshalIndent(lb, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal error: %w", err)
+	}
+	return mcp.NewToolResultText(string(jsonLB)), nil
+}
+
+// deleteLoadBalancer deletes a load balancer
+func (l *LoadBalancerTool) deleteLoadBalancer(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+	_, err := l.client.LoadBalancers.Delete(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Load balancer deleted successfully"), nil
+}
+
+// addDroplets adds one or more droplets to a load balancer
+func (l *LoadBalancerTool) addDroplets(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+	rawIDs, _ := req.GetArguments()["DropletIDs"].([]any)
+	dropletIDs := parseDropletIDs(rawIDs)
+	if len(dropletIDs) == 0 {
+		return mcp.NewToolResultError("At least one droplet ID is required"), nil
+	}
+	_, err := l.client.LoadBalancers.AddDroplets(ctx, id, dropletIDs...)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Droplet(s) added to load balancer successfully"), nil
+}
+
+// removeDroplets removes one or more droplets from a load balancer
+func (l *LoadBalancerTool) removeDroplets(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+	rawIDs, _ := req.GetArguments()["DropletIDs"].([]any)
+	dropletIDs := parseDropletIDs(rawIDs)
+	if len(dropletIDs) == 0 {
+		return mcp.NewToolResultError("At least one droplet ID is required"), nil
+	}
+	_, err := l.client.LoadBalancers.RemoveDroplets(ctx, id, dropletIDs...)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Droplet(s) removed from load balancer successfully"), nil
+}
+
+// addForwardingRules adds one or more forwarding rules to a load balancer
+func (l *LoadBalancerTool) addForwardingRules(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+	rawRules, _ := req.GetArguments()["ForwardingRules"].([]any)
+	rules, err := parseForwardingRules(rawRules)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(rules) == 0 {
+		return mcp.NewToolResultError("At least one forwarding rule is required"), nil
+	}
+	_, err = l.client.LoadBalancers.AddForwardingRules(ctx, id, rules...)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Forwarding rule(s) added to load balancer successfully"), nil
+}
+
+// removeForwardingRules removes one or more forwarding rules from a load balancer
+func (l *LoadBalancerTool) removeForwardingRules(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+	rawRules, _ := req.GetArguments()["ForwardingRules"].([]any)
+	rules, err := parseForwardingRules(rawRules)
+	if err != nil {
+		return mcp.NewToolResultError(err.Error()), nil
+	}
+	if len(rules) == 0 {
+		return mcp.NewToolResultError("At least one forwarding rule is required"), nil
+	}
+	_, err = l.client.LoadBalancers.RemoveForwardingRules(ctx, id, rules...)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Forwarding rule(s) removed from load balancer successfully"), nil
+}
+
+// purgeCache purges the CDN cache of a global load balancer
+func (l *LoadBalancerTool) purgeCache(ctx context.Context, req mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	id, ok := req.GetArguments()["ID"].(string)
+	if !ok || id == "" {
+		return mcp.NewToolResultError("Load balancer ID is required"), nil
+	}
+	_, err := l.client.LoadBalancers.PurgeCache(ctx, id)
+	if err != nil {
+		return mcp.NewToolResultErrorFromErr("api error", err), nil
+	}
+	return mcp.NewToolResultText("Load balancer cache purged successfully"), nil
+}
+
+// loadBalancerConfigOptions returns the tool options shared by create and update
+func loadBalancerConfigOptions() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("Region", mcp.Description("Slug of the region (regional load balancers only, e.g., 'nyc3')")),
+		mcp.WithNumber("SizeUnit", mcp.Description("Number of nodes of the load balancer (1-100)")),
+		mcp.WithString("VPCUUID", mcp.Description("UUID of the VPC the load balancer is placed in")),
+		mcp.WithArray("ForwardingRules", mcp.Description("Forwarding rules of a regional load balancer"), mcp.Items(forwardingRuleSchema)),
+		mcp.WithObject("HealthCheck", mcp.Description("Health check settings"), mcp.Properties(healthCheckSchema)),
+		mcp.WithObject("StickySessions", mcp.Description("Sticky sessions settings"), mcp.Properties(stickySessionsSchema)),
+		mcp.WithArray("DropletIDs", mcp.Description("Droplet IDs to balance traffic across (mutually exclusive with Tag)"), mcp.Items(map[string]any{
+			"type":        "number",
+			"description": "droplet ID to add to the load balancer",
+		})),
+		mcp.WithString("Tag", mcp.Description("Balance traffic across droplets with this tag (mutually exclusive with DropletIDs)")),
+		mcp.WithBoolean("RedirectHttpToHttps", mcp.Description("Redirect HTTP traffic on port 80 to HTTPS on port 443")),
+		mcp.WithBoolean("EnableProxyProtocol", mcp.Description("Enable the PROXY protocol")),
+		mcp.WithBoolean("EnableBackendKeepalive", mcp.Description("Use HTTP keepalive connections to the droplets")),
+		mcp.WithArray("Domains", mcp.Description("Domains served by a global load balancer"), mcp.Items(domainSchema)),
+		mcp.WithObject("GLBSettings", mcp.Description("Settings of a global load balancer"), mcp.Properties(glbSettingsSchema)),
+		mcp.WithArray("TargetLoadBalancerIDs", mcp.Description("IDs of the regional load balancers a global load balancer sends traffic to"), mcp.Items(map[string]any{
+			"type":        "string",
+			"description": "ID of a regional load balancer",
+		})),
+	}
+}
+
+// Tools returns a list of tool functions
+func (l *LoadBalancerTool) Tools() []server.ServerTool {
+	createOptions := append([]mcp.ToolOption{
+		mcp.WithDescription("Create a new load balancer. Regional load balancers require Region and ForwardingRules; global load balancers (Type 'GLOBAL') require Domains and GLBSettings."),
+		mcp.WithString("Name", mcp.Required(), mcp.Description("Name of the load balancer")),
+		mcp.WithString("Type", mcp.DefaultString(godo.LoadBalancerTypeRegional), mcp.Description("Type of the load balancer: 'REGIONAL', 'REGIONAL_NETWORK' or 'GLOBAL'")),
+		mcp.WithString("ProjectID", mcp.Description("ID of the project to assign the load balancer to (defaults to the default project)")),
+		mcp.WithArray("Tags", mcp.Description("Tags to apply to the load balancer. Tags can only be set on creation."), mcp.Items(map[string]any{
+			"type":        "string",
+			"description": "Tag to apply",
+		})),
+	}, loadBalancerConfigOptions()...)
+
+	updateOptions := append([]mcp.ToolOption{
+		mcp.WithDescription("Update a load balancer. Only the provided attributes are changed; ForwardingRules, DropletIDs and Domains replace the current values."),
+		mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the load balancer to update")),
+		mcp.WithString("Name", mcp.Description("New name of the load balancer")),
+	}, loadBalancerConfigOptions()...)
+
+	return []server.ServerTool{
+		{
+			Handler: l.getLoadBalancer,
+			Tool: mcp.NewTool("load-balancer-get",
+				mcp.WithDescription("Get load balancer information by ID"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the load balancer")),
+			),
+		},
+		{
+			Handler: l.listLoadBalancers,
+			Tool: mcp.NewTool("load-balancer-list",
+				mcp.WithDescription("List load balancers with pagination"),
+				mcp.WithNumber("Page", mcp.DefaultNumber(1), mcp.Description("Page number")),
+				mcp.WithNumber("PerPage", mcp.DefaultNumber(20), mcp.Description("Items per page")),
+			),
+		},
+		{
+			Handler: l.createLoadBalancer,
+			Tool:    mcp.NewTool("load-balancer-create", createOptions...),
+		},
+		{
+			Handler: l.updateLoadBalancer,
+			Tool:    mcp.NewTool("load-balancer-update", updateOptions...),
+		},
+		{
+			Handler: l.deleteLoadBalancer,
+			Tool: mcp.NewTool("load-balancer-delete",
+				mcp.WithDescription("Delete a load balancer"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the load balancer to delete")),
+			),
+		},
+		{
+			Handler: l.addDroplets,
+			Tool: mcp.NewTool("load-balancer-add-droplets",
+				mcp.WithDescription("Add one or more droplets to a load balancer"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the load balancer")),
+				mcp.WithArray("DropletIDs", mcp.Required(), mcp.Description("Droplet IDs to add to the load balancer"), mcp.Items(map[string]any{
+					"type":        "number",
+					"description": "droplet ID to add to the load balancer",
+				})),
+			),
+		},
+		{
+			Handler: l.removeDroplets,
+			Tool: mcp.NewTool("load-balancer-remove-droplets",
+				mcp.WithDescription("Remove one or more droplets from a load balancer"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the load balancer")),
+				mcp.WithArray("DropletIDs", mcp.Required(), mcp.Description("Droplet IDs to remove from the load balancer"), mcp.Items(map[string]any{
+					"type":        "number",
+					"description": "droplet ID to remove from the load balancer",
+				})),
+			),
+		},
+		{
+			Handler: l.addForwardingRules,
+			Tool: mcp.NewTool("load-balancer-add-forwarding-rules",
+				mcp.WithDescription("Add one or more forwarding rules to a load balancer"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the load balancer")),
+				mcp.WithArray("ForwardingRules", mcp.Required(), mcp.Description("Forwarding rules to add"), mcp.Items(forwardingRuleSchema)),
+			),
+		},
+		{
+			Handler: l.removeForwardingRules,
+			Tool: mcp.NewTool("load-balancer-remove-forwarding-rules",
+				mcp.WithDescription("Remove one or more forwarding rules from a load balancer"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the load balancer")),
+				mcp.WithArray("ForwardingRules", mcp.Required(), mcp.Description("Forwarding rules to remove"), mcp.Items(forwardingRuleSchema)),
+			),
+		},
+		{
+			Handler: l.purgeCache,
+			Tool: mcp.NewTool("load-balancer-purge-cache",
+				mcp.WithDescription("Purge the CDN cache of a global load balancer"),
+				mcp.WithString("ID", mcp.Required(), mcp.Description("ID of the global load balancer")),
+			),
+		},
+	}
+}
@@ -0,0 +1,330 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/digitalocean/godo"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupLoadBalancerToolWithMock(loadBalancers *MockLoadBalancersService) *LoadBalancerTool {
+	client := &godo.Client{}
+	client.LoadBalancers = loadBalancers
+	return NewLoadBalancerTool(client)
+}
+
+func TestLoadBalancerTool_createLoadBalancer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		mockSetup   func(*MockLoadBalancersService)
+		expectError bool
+	}{
+		{
+			name: "Regional load balancer with HTTPS termination",
+			args: map[string]any{
+				"Name":   "web-lb",
+				"Region": "nyc3",
+				"ForwardingRules": []any{
+					map[string]any{"EntryProtocol": "HTTPS", "EntryPort": float64(443), "TargetProtocol": "http", "TargetPort": float64(80), "CertificateID": "cert-1"},
+				},
+				"HealthCheck":         map[string]any{"Protocol": "http", "Port": float64(80), "Path": "/healthz"},
+				"StickySessions":      map[string]any{"Type": "cookies", "CookieName": "lb", "CookieTtlSeconds": float64(300)},
+				"Tag":                 "web",
+				"RedirectHttpToHttps": true,
+				"Tags":                []any{"prod"},
+			},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().
+					Create(gomock.Any(), &godo.LoadBalancerRequest{
+						Name:   "web-lb",
+						Region: "nyc3",
+						ForwardingRules: []godo.ForwardingRule{
+							{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "http", TargetPort: 80, CertificateID: "cert-1"},
+						},
+						HealthCheck:         &godo.HealthCheck{Protocol: "http", Port: 80, Path: "/healthz"},
+						StickySessions:      &godo.StickySessions{Type: "cookies", CookieName: "lb", CookieTtlSeconds: 300},
+						Tag:                 "web",
+						RedirectHttpToHttps: true,
+						Tags:                []string{"prod"},
+					}).
+					Return(&godo.LoadBalancer{ID: "lb-1", Name: "web-lb"}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "Global load balancer",
+			args: map[string]any{
+				"Name":                  "glb",
+				"Type":                  "global",
+				"Domains":               []any{map[string]any{"Name": "example.com", "IsManaged": true}},
+				"GLBSettings":           map[string]any{"TargetProtocol": "http", "TargetPort": float64(80), "CDN": true},
+				"TargetLoadBalancerIDs": []any{"lb-1", "lb-2"},
+			},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().
+					Create(gomock.Any(), &godo.LoadBalancerRequest{
+						Name:                  "glb",
+						Type:                  godo.LoadBalancerTypeGlobal,
+						Domains:               []*godo.LBDomain{{Name: "example.com", IsManaged: true}},
+						GLBSettings:           &godo.GLBSettings{TargetProtocol: "http", TargetPort: 80, CDN: &godo.CDNSettings{IsEnabled: true}},
+						TargetLoadBalancerIDs: []string{"lb-1", "lb-2"},
+					}).
+					Return(&godo.LoadBalancer{ID: "glb-1", Name: "glb"}, nil, nil).
+					Times(1)
+			},
+		},
+		{
+			name: "API error",
+			args: map[string]any{
+				"Name":            "web-lb",
+				"Region":          "nyc3",
+				"ForwardingRules": []any{map[string]any{"EntryProtocol": "http", "EntryPort": float64(80), "TargetProtocol": "http", "TargetPort": float64(80)}},
+			},
+			mockSetup: func(m *MockLoadBalancersService) {
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					Return(nil, nil, errors.New("api error")).
+					Times(1)
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing forwarding rules",
+			args:        map[string]any{"Name": "web-lb", "Region": "nyc3"},
+			expectError: true,
+		},
+		{
+			name: "Incomplete forwarding rule",
+			args: map[string]any{
+				"Name":            "web-lb",
+				"Region":          "nyc3",
+				"ForwardingRules": []any{map[string]any{"EntryProtocol": "http", "EntryPort": float64(80)}},
+			},
+			expectError: true,
+		},
+		{
+			name: "Droplet IDs and tag together",
+			args: map[string]any{
+				"Name":            "web-lb",
+				"Region":          "nyc3",
+				"ForwardingRules": []any{map[string]any{"EntryProtocol": "http", "EntryPort": float64(80), "TargetProtocol": "http", "TargetPort": float64(80)}},
+				"DropletIDs":      []any{float64(1)},
+				"Tag":             "web",
+			},
+			expectError: true,
+		},
+		{
+			name:        "Global load balancer without settings",
+			args:        map[string]any{"Name": "glb", "Type": "GLOBAL"},
+			expectError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockLBs := NewMockLoadBalancersService(ctrl)
+			if tc.mockSetup != nil {
+				tc.mockSetup(mockLBs)
+			}
+			tool := setupLoadBalancerToolWithMock(mockLBs)
+			req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: tc.args}}
+			resp, err := tool.createLoadBalancer(context.Background(), req)
+			if tc.expectError {
+				require.NotNil(t, resp)
+				require.True(t, resp.IsError)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			require.False(t, resp.IsError)
+		})
+	}
+}
+
+func TestLoadBalancerTool_updateLoadBalancer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	current := &godo.LoadBalancer{
+		ID:     "lb-1",
+		Name:   "web-lb",
+		Region: &godo.Region{Slug: "nyc3"},
+		ForwardingRules: []godo.ForwardingRule{
+			{EntryProtocol: "http", EntryPort: 80, TargetProtocol: "http", TargetPort: 80},
+		},
+		HealthCheck: &godo.HealthCheck{Protocol: "tcp", Port: 80},
+		Tag:         "web",
+		DropletIDs:  []int{1, 2},
+	}
+
+	mockLBs := NewMockLoadBalancersService(ctrl)
+	mockLBs.EXPECT().
+		Get(gomock.Any(), "lb-1").
+		Return(current, nil, nil).
+		Times(1)
+	mockLBs.EXPECT().
+		Update(gomock.Any(), "lb-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, lbr *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+			require.Equal(t, "web-lb", lbr.Name)
+			require.Equal(t, "nyc3", lbr.Region)
+			require.Equal(t, current.ForwardingRules, lbr.ForwardingRules)
+			require.Equal(t, &godo.HealthCheck{Protocol: "http", Port: 80, Path: "/healthz"}, lbr.HealthCheck)
+			require.Equal(t, "web", lbr.Tag)
+			require.Empty(t, lbr.DropletIDs)
+			return &godo.LoadBalancer{ID: "lb-1"}, nil, nil
+		}).
+		Times(1)
+	tool := setupLoadBalancerToolWithMock(mockLBs)
+
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID":          "lb-1",
+		"HealthCheck": map[string]any{"Protocol": "http", "Port": float64(80), "Path": "/healthz"},
+	}}}
+	resp, err := tool.updateLoadBalancer(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.updateLoadBalancer(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestLoadBalancerTool_updateLoadBalancerNestedFields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	healthCheck := &godo.HealthCheck{
+		Protocol:               "http",
+		Port:                   8080,
+		Path:                   "/healthz",
+		CheckIntervalSeconds:   10,
+		ResponseTimeoutSeconds: 5,
+		HealthyThreshold:       3,
+		UnhealthyThreshold:     2,
+	}
+	current := &godo.LoadBalancer{
+		ID:             "lb-1",
+		Name:           "web-lb",
+		Region:         &godo.Region{Slug: "nyc3"},
+		HealthCheck:    healthCheck,
+		StickySessions: &godo.StickySessions{Type: "cookies", CookieName: "lb", CookieTtlSeconds: 300},
+		GLBSettings: &godo.GLBSettings{
+			TargetProtocol:   "https",
+			TargetPort:       443,
+			CDN:              &godo.CDNSettings{IsEnabled: true},
+			RegionPriorities: map[string]uint32{"nyc1": 1, "ams3": 2},
+		},
+	}
+
+	mockLBs := NewMockLoadBalancersService(ctrl)
+	mockLBs.EXPECT().
+		Get(gomock.Any(), "lb-1").
+		Return(current, nil, nil).
+		Times(1)
+	mockLBs.EXPECT().
+		Update(gomock.Any(), "lb-1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, lbr *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+			require.Equal(t, &godo.HealthCheck{
+				Protocol:               "http",
+				Port:                   8080,
+				Path:                   "/ready",
+				CheckIntervalSeconds:   10,
+				ResponseTimeoutSeconds: 5,
+				HealthyThreshold:       3,
+				UnhealthyThreshold:     2,
+			}, lbr.HealthCheck)
+			require.Equal(t, &godo.StickySessions{Type: "cookies", CookieName: "lb", CookieTtlSeconds: 600}, lbr.StickySessions)
+			require.Equal(t, &godo.GLBSettings{
+				TargetProtocol:    "https",
+				TargetPort:        443,
+				CDN:               &godo.CDNSettings{IsEnabled: true},
+				RegionPriorities:  map[string]uint32{"nyc1": 1, "ams3": 2},
+				FailoverThreshold: 50,
+			}, lbr.GLBSettings)
+			return &godo.LoadBalancer{ID: "lb-1"}, nil, nil
+		}).
+		Times(1)
+	tool := setupLoadBalancerToolWithMock(mockLBs)
+
+	req := mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID":             "lb-1",
+		"HealthCheck":    map[string]any{"Path": "/ready"},
+		"StickySessions": map[string]any{"CookieTtlSeconds": float64(600)},
+		"GLBSettings":    map[string]any{"FailoverThreshold": float64(50)},
+	}}}
+	resp, err := tool.updateLoadBalancer(context.Background(), req)
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+	require.Equal(t, "/healthz", healthCheck.Path)
+}
+
+func TestLoadBalancerTool_droplets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLBs := NewMockLoadBalancersService(ctrl)
+	mockLBs.EXPECT().
+		AddDroplets(gomock.Any(), "lb-1", 1, 2).
+		Return(nil, nil).
+		Times(1)
+	mockLBs.EXPECT().
+		RemoveDroplets(gomock.Any(), "lb-1", 2).
+		Return(nil, errors.New("api error")).
+		Times(1)
+	tool := setupLoadBalancerToolWithMock(mockLBs)
+
+	resp, err := tool.addDroplets(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID":         "lb-1",
+		"DropletIDs": []any{float64(1), float64(2)},
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.removeDroplets(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID":         "lb-1",
+		"DropletIDs": []any{float64(2)},
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+
+	resp, err = tool.addDroplets(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{"ID": "lb-1"}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
+
+func TestLoadBalancerTool_addForwardingRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLBs := NewMockLoadBalancersService(ctrl)
+	mockLBs.EXPECT().
+		AddForwardingRules(gomock.Any(), "lb-1", godo.ForwardingRule{EntryProtocol: "https", EntryPort: 443, TargetProtocol: "https", TargetPort: 443, TlsPassthrough: true}).
+		Return(nil, nil).
+		Times(1)
+	tool := setupLoadBalancerToolWithMock(mockLBs)
+
+	resp, err := tool.addForwardingRules(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID": "lb-1",
+		"ForwardingRules": []any{
+			map[string]any{"EntryProtocol": "https", "EntryPort": float64(443), "TargetProtocol": "https", "TargetPort": float64(443), "TlsPassthrough": true},
+		},
+	}}})
+	require.NoError(t, err)
+	require.False(t, resp.IsError)
+
+	resp, err = tool.addForwardingRules(context.Background(), mcp.CallToolRequest{Params: mcp.CallToolParams{Arguments: map[string]any{
+		"ID": "lb-1",
+		"ForwardingRules": []any{
+			map[string]any{"EntryProtocol": "https", "EntryPort": float64(443), "TargetProtocol": "https", "TargetPort": float64(443), "TlsPassthrough": true, "CertificateID": "cert-1"},
+		},
+	}}})
+	require.NoError(t, err)
+	require.True(t, resp.IsError)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/digitalocean/godo (interfaces: CertificatesService,DomainsService,FirewallsService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService)
+// Source: github.com/digitalocean/godo (interfaces: CertificatesService,DomainsService,FirewallsService,LoadBalancersService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService)
 //
 // Generated by this command:
 //
-//	mockgen -destination=./mocks.go -package networking github.com/digitalocean/godo CertificatesService,DomainsService,FirewallsService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService
+//	mockgen -destination=./mocks.go -package networking github.com/digitalocean/godo CertificatesService,DomainsService,FirewallsService,LoadBalancersService,PartnerAttachmentService,ReservedIPsService,ReservedIPV6sService,ReservedIPActionsService,ReservedIPV6ActionsService,VPCsService
 //
 
 // Package networking is a generated GoMock package.
@@ -21,6 +21,7 @@ import (
 type MockCertificatesService struct {
 	ctrl     *gomock.Controller
 	recorder *MockCertificatesServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockCertificatesServiceMockRecorder is the mock recorder for MockCertificatesService.
@@ -123,6 +124,7 @@ func (mr *MockCertificatesServiceMockRecorder) ListByName(arg0, arg1, arg2 any)
 type MockDomainsService struct {
 	ctrl     *gomock.Controller
 	recorder *MockDomainsServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockDomainsServiceMockRecorder is the mock recorder for MockDomainsService.
@@ -336,6 +338,7 @@ func (mr *MockDomainsServiceMockRecorder) RecordsByTypeAndName(arg0, arg1, arg2,
 type MockFirewallsService struct {
 	ctrl     *gomock.Controller
 	recorder *MockFirewallsServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockFirewallsServiceMockRecorder is the mock recorder for MockFirewallsService.
@@ -560,10 +563,241 @@ func (mr *MockFirewallsServiceMockRecorder) Update(arg0, arg1, arg2 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockFirewallsService)(nil).Update), arg0, arg1, arg2)
 }
 
+// MockLoadBalancersService is a mock of LoadBalancersService interface.
+type MockLoadBalancersService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadBalancersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLoadBalancersServiceMockRecorder is the mock recorder for MockLoadBalancersService.
+type MockLoadBalancersServiceMockRecorder struct {
+	mock *MockLoadBalancersService
+}
+
+// NewMockLoadBalancersService creates a new mock instance.
+func NewMockLoadBalancersService(ctrl *gomock.Controller) *MockLoadBalancersService {
+	mock := &MockLoadBalancersService{ctrl: ctrl}
+	mock.recorder = &MockLoadBalancersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadBalancersService) EXPECT() *MockLoadBalancersServiceMockRecorder {
+	return m.recorder
+}
+
+// AddDroplets mocks base method.
+func (m *MockLoadBalancersService) AddDroplets(ctx context.Context, lbID string, dropletIDs ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range dropletIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddDroplets indicates an expected call of AddDroplets.
+func (mr *MockLoadBalancersServiceMockRecorder) AddDroplets(ctx, lbID any, dropletIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, dropletIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).AddDroplets), varargs...)
+}
+
+// AddForwardingRules mocks base method.
+func (m *MockLoadBalancersService) AddForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range rules {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddForwardingRules", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddForwardingRules indicates an expected call of AddForwardingRules.
+func (mr *MockLoadBalancersServiceMockRecorder) AddForwardingRules(ctx, lbID any, rules ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, rules...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).AddForwardingRules), varargs...)
+}
+
+// Create mocks base method.
+func (m *MockLoadBalancersService) Create(arg0 context.Context, arg1 *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLoadBalancersServiceMockRecorder) Create(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLoadBalancersService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockLoadBalancersService) Delete(ctx context.Context, lbID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, lbID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLoadBalancersServiceMockRecorder) Delete(ctx, lbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLoadBalancersService)(nil).Delete), ctx, lbID)
+}
+
+// Get mocks base method.
+func (m *MockLoadBalancersService) Get(arg0 context.Context, arg1 string) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockLoadBalancersServiceMockRecorder) Get(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockLoadBalancersService)(nil).Get), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockLoadBalancersService) List(arg0 context.Context, arg1 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockLoadBalancersServiceMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLoadBalancersService)(nil).List), arg0, arg1)
+}
+
+// ListByNames mocks base method.
+func (m *MockLoadBalancersService) ListByNames(arg0 context.Context, arg1 []string, arg2 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByNames", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByNames indicates an expected call of ListByNames.
+func (mr *MockLoadBalancersServiceMockRecorder) ListByNames(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByNames", reflect.TypeOf((*MockLoadBalancersService)(nil).ListByNames), arg0, arg1, arg2)
+}
+
+// ListByUUIDs mocks base method.
+func (m *MockLoadBalancersService) ListByUUIDs(arg0 context.Context, arg1 []string, arg2 *godo.ListOptions) ([]godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUUIDs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByUUIDs indicates an expected call of ListByUUIDs.
+func (mr *MockLoadBalancersServiceMockRecorder) ListByUUIDs(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUUIDs", reflect.TypeOf((*MockLoadBalancersService)(nil).ListByUUIDs), arg0, arg1, arg2)
+}
+
+// PurgeCache mocks base method.
+func (m *MockLoadBalancersService) PurgeCache(ctx context.Context, lbID string) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeCache", ctx, lbID)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeCache indicates an expected call of PurgeCache.
+func (mr *MockLoadBalancersServiceMockRecorder) PurgeCache(ctx, lbID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeCache", reflect.TypeOf((*MockLoadBalancersService)(nil).PurgeCache), ctx, lbID)
+}
+
+// RemoveDroplets mocks base method.
+func (m *MockLoadBalancersService) RemoveDroplets(ctx context.Context, lbID string, dropletIDs ...int) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range dropletIDs {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveDroplets", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveDroplets indicates an expected call of RemoveDroplets.
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveDroplets(ctx, lbID any, dropletIDs ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, dropletIDs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDroplets", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveDroplets), varargs...)
+}
+
+// RemoveForwardingRules mocks base method.
+func (m *MockLoadBalancersService) RemoveForwardingRules(ctx context.Context, lbID string, rules ...godo.ForwardingRule) (*godo.Response, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, lbID}
+	for _, a := range rules {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveForwardingRules", varargs...)
+	ret0, _ := ret[0].(*godo.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveForwardingRules indicates an expected call of RemoveForwardingRules.
+func (mr *MockLoadBalancersServiceMockRecorder) RemoveForwardingRules(ctx, lbID any, rules ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, lbID}, rules...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveForwardingRules", reflect.TypeOf((*MockLoadBalancersService)(nil).RemoveForwardingRules), varargs...)
+}
+
+// Update mocks base method.
+func (m *MockLoadBalancersService) Update(ctx context.Context, lbID string, lbr *godo.LoadBalancerRequest) (*godo.LoadBalancer, *godo.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, lbID, lbr)
+	ret0, _ := ret[0].(*godo.LoadBalancer)
+	ret1, _ := ret[1].(*godo.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLoadBalancersServiceMockRecorder) Update(ctx, lbID, lbr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLoadBalancersService)(nil).Update), ctx, lbID, lbr)
+}
+
 // MockPartnerAttachmentService is a mock of PartnerAttachmentService interface.
 type MockPartnerAttachmentService struct {
 	ctrl     *gomock.Controller
 	recorder *MockPartnerAttachmentServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockPartnerAttachmentServiceMockRecorder is the mock recorder for MockPartnerAttachmentService.
@@ -631,9 +865,9 @@ func (mr *MockPartnerAttachmentServiceMockRecorder) Get(arg0, arg1 any) *gomock.
 }
 
 // GetBGPAuthKey mocks base method.
-func (m *MockPartnerAttachmentService) GetBGPAuthKey(arg0 context.Context, arg1 string) (*godo.BgpAuthKey, *godo.Response, error) {
+func (m *MockPartnerAttachmentService) GetBGPAuthKey(ctx context.Context, iaID string) (*godo.BgpAuthKey, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBGPAuthKey", arg0, arg1)
+	ret := m.ctrl.Call(m, "GetBGPAuthKey", ctx, iaID)
 	ret0, _ := ret[0].(*godo.BgpAuthKey)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -641,9 +875,9 @@ func (m *MockPartnerAttachmentService) GetBGPAuthKey(arg0 context.Context, arg1
 }
 
 // GetBGPAuthKey indicates an expected call of GetBGPAuthKey.
-func (mr *MockPartnerAttachmentServiceMockRecorder) GetBGPAuthKey(arg0, arg1 any) *gomock.Call {
+func (mr *MockPartnerAttachmentServiceMockRecorder) GetBGPAuthKey(ctx, iaID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBGPAuthKey", reflect.TypeOf((*MockPartnerAttachmentService)(nil).GetBGPAuthKey), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBGPAuthKey", reflect.TypeOf((*MockPartnerAttachmentService)(nil).GetBGPAuthKey), ctx, iaID)
 }
 
 // GetServiceKey mocks base method.
@@ -695,9 +929,9 @@ func (mr *MockPartnerAttachmentServiceMockRecorder) ListRoutes(arg0, arg1, arg2
 }
 
 // RegenerateServiceKey mocks base method.
-func (m *MockPartnerAttachmentService) RegenerateServiceKey(arg0 context.Context, arg1 string) (*godo.RegenerateServiceKey, *godo.Response, error) {
+func (m *MockPartnerAttachmentService) RegenerateServiceKey(ctx context.Context, iaID string) (*godo.RegenerateServiceKey, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RegenerateServiceKey", arg0, arg1)
+	ret := m.ctrl.Call(m, "RegenerateServiceKey", ctx, iaID)
 	ret0, _ := ret[0].(*godo.RegenerateServiceKey)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -705,9 +939,9 @@ func (m *MockPartnerAttachmentService) RegenerateServiceKey(arg0 context.Context
 }
 
 // RegenerateServiceKey indicates an expected call of RegenerateServiceKey.
-func (mr *MockPartnerAttachmentServiceMockRecorder) RegenerateServiceKey(arg0, arg1 any) *gomock.Call {
+func (mr *MockPartnerAttachmentServiceMockRecorder) RegenerateServiceKey(ctx, iaID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateServiceKey", reflect.TypeOf((*MockPartnerAttachmentService)(nil).RegenerateServiceKey), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateServiceKey", reflect.TypeOf((*MockPartnerAttachmentService)(nil).RegenerateServiceKey), ctx, iaID)
 }
 
 // SetRoutes mocks base method.
@@ -746,6 +980,7 @@ func (mr *MockPartnerAttachmentServiceMockRecorder) Update(arg0, arg1, arg2 any)
 type MockReservedIPsService struct {
 	ctrl     *gomock.Controller
 	recorder *MockReservedIPsServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockReservedIPsServiceMockRecorder is the mock recorder for MockReservedIPsService.
@@ -832,6 +1067,7 @@ func (mr *MockReservedIPsServiceMockRecorder) List(arg0, arg1 any) *gomock.Call
 type MockReservedIPV6sService struct {
 	ctrl     *gomock.Controller
 	recorder *MockReservedIPV6sServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockReservedIPV6sServiceMockRecorder is the mock recorder for MockReservedIPV6sService.
@@ -918,6 +1154,7 @@ func (mr *MockReservedIPV6sServiceMockRecorder) List(arg0, arg1 any) *gomock.Cal
 type MockReservedIPActionsService struct {
 	ctrl     *gomock.Controller
 	recorder *MockReservedIPActionsServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockReservedIPActionsServiceMockRecorder is the mock recorder for MockReservedIPActionsService.
@@ -938,9 +1175,9 @@ func (m *MockReservedIPActionsService) EXPECT() *MockReservedIPActionsServiceMoc
 }
 
 // Assign mocks base method.
-func (m *MockReservedIPActionsService) Assign(arg0 context.Context, arg1 string, arg2 int) (*godo.Action, *godo.Response, error) {
+func (m *MockReservedIPActionsService) Assign(ctx context.Context, ip string, dropletID int) (*godo.Action, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Assign", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Assign", ctx, ip, dropletID)
 	ret0, _ := ret[0].(*godo.Action)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -948,15 +1185,15 @@ func (m *MockReservedIPActionsService) Assign(arg0 context.Context, arg1 string,
 }
 
 // Assign indicates an expected call of Assign.
-func (mr *MockReservedIPActionsServiceMockRecorder) Assign(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockReservedIPActionsServiceMockRecorder) Assign(ctx, ip, dropletID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Assign), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Assign), ctx, ip, dropletID)
 }
 
 // Get mocks base method.
-func (m *MockReservedIPActionsService) Get(arg0 context.Context, arg1 string, arg2 int) (*godo.Action, *godo.Response, error) {
+func (m *MockReservedIPActionsService) Get(ctx context.Context, ip string, actionID int) (*godo.Action, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Get", ctx, ip, actionID)
 	ret0, _ := ret[0].(*godo.Action)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -964,15 +1201,15 @@ func (m *MockReservedIPActionsService) Get(arg0 context.Context, arg1 string, ar
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockReservedIPActionsServiceMockRecorder) Get(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockReservedIPActionsServiceMockRecorder) Get(ctx, ip, actionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservedIPActionsService)(nil).Get), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReservedIPActionsService)(nil).Get), ctx, ip, actionID)
 }
 
 // List mocks base method.
-func (m *MockReservedIPActionsService) List(arg0 context.Context, arg1 string, arg2 *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
+func (m *MockReservedIPActionsService) List(ctx context.Context, ip string, opt *godo.ListOptions) ([]godo.Action, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "List", ctx, ip, opt)
 	ret0, _ := ret[0].([]godo.Action)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -980,15 +1217,15 @@ func (m *MockReservedIPActionsService) List(arg0 context.Context, arg1 string, a
 }
 
 // List indicates an expected call of List.
-func (mr *MockReservedIPActionsServiceMockRecorder) List(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockReservedIPActionsServiceMockRecorder) List(ctx, ip, opt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservedIPActionsService)(nil).List), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservedIPActionsService)(nil).List), ctx, ip, opt)
 }
 
 // Unassign mocks base method.
-func (m *MockReservedIPActionsService) Unassign(arg0 context.Context, arg1 string) (*godo.Action, *godo.Response, error) {
+func (m *MockReservedIPActionsService) Unassign(ctx context.Context, ip string) (*godo.Action, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unassign", arg0, arg1)
+	ret := m.ctrl.Call(m, "Unassign", ctx, ip)
 	ret0, _ := ret[0].(*godo.Action)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -996,15 +1233,16 @@ func (m *MockReservedIPActionsService) Unassign(arg0 context.Context, arg1 strin
 }
 
 // Unassign indicates an expected call of Unassign.
-func (mr *MockReservedIPActionsServiceMockRecorder) Unassign(arg0, arg1 any) *gomock.Call {
+func (mr *MockReservedIPActionsServiceMockRecorder) Unassign(ctx, ip any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Unassign), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockReservedIPActionsService)(nil).Unassign), ctx, ip)
 }
 
 // MockReservedIPV6ActionsService is a mock of ReservedIPV6ActionsService interface.
 type MockReservedIPV6ActionsService struct {
 	ctrl     *gomock.Controller
 	recorder *MockReservedIPV6ActionsServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockReservedIPV6ActionsServiceMockRecorder is the mock recorder for MockReservedIPV6ActionsService.
@@ -1025,9 +1263,9 @@ func (m *MockReservedIPV6ActionsService) EXPECT() *MockReservedIPV6ActionsServic
 }
 
 // Assign mocks base method.
-func (m *MockReservedIPV6ActionsService) Assign(arg0 context.Context, arg1 string, arg2 int) (*godo.Action, *godo.Response, error) {
+func (m *MockReservedIPV6ActionsService) Assign(ctx context.Context, ip string, dropletID int) (*godo.Action, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Assign", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "Assign", ctx, ip, dropletID)
 	ret0, _ := ret[0].(*godo.Action)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -1035,15 +1273,15 @@ func (m *MockReservedIPV6ActionsService) Assign(arg0 context.Context, arg1 strin
 }
 
 // Assign indicates an expected call of Assign.
-func (mr *MockReservedIPV6ActionsServiceMockRecorder) Assign(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockReservedIPV6ActionsServiceMockRecorder) Assign(ctx, ip, dropletID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockReservedIPV6ActionsService)(nil).Assign), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Assign", reflect.TypeOf((*MockReservedIPV6ActionsService)(nil).Assign), ctx, ip, dropletID)
 }
 
 // Unassign mocks base method.
-func (m *MockReservedIPV6ActionsService) Unassign(arg0 context.Context, arg1 string) (*godo.Action, *godo.Response, error) {
+func (m *MockReservedIPV6ActionsService) Unassign(ctx context.Context, ip string) (*godo.Action, *godo.Response, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Unassign", arg0, arg1)
+	ret := m.ctrl.Call(m, "Unassign", ctx, ip)
 	ret0, _ := ret[0].(*godo.Action)
 	ret1, _ := ret[1].(*godo.Response)
 	ret2, _ := ret[2].(error)
@@ -1051,15 +1289,16 @@ func (m *MockReservedIPV6ActionsService) Unassign(arg0 context.Context, arg1 str
 }
 
 // Unassign indicates an expected call of Unassign.
-func (mr *MockReservedIPV6ActionsServiceMockRecorder) Unassign(arg0, arg1 any) *gomock.Call {
+func (mr *MockReservedIPV6ActionsServiceMockRecorder) Unassign(ctx, ip any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockReservedIPV6ActionsService)(nil).Unassign), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unassign", reflect.TypeOf((*MockReservedIPV6ActionsService)(nil).Unassign), ctx, ip)
 }
 
 // MockVPCsService is a mock of VPCsService interface.
 type MockVPCsService struct {
 	ctrl     *gomock.Controller
 	recorder *MockVPCsServiceMockRecorder
+	isgomock struct{}
 }
 
 // MockVPCsServiceMockRecorder is the mock recorder for MockVPCsService.
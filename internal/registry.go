@@ -11,9 +11,11 @@ import (
 	"mcp-digitalocean/internal/account"
 	"mcp-digitalocean/internal/apps"
 	"mcp-digitalocean/internal/common"
+	"mcp-digitalocean/internal/containerregistry"
 	"mcp-digitalocean/internal/dbaas"
 	"mcp-digitalocean/internal/doks"
 	"mcp-digitalocean/internal/droplet"
+	"mcp-digitalocean/internal/functions"
 	"mcp-digitalocean/internal/insights"
 	"mcp-digitalocean/internal/marketplace"
 	"mcp-digitalocean/internal/networking"
@@ -31,6 +33,8 @@ var supportedServices = map[string]struct{}{
 	"marketplace": {},
 	"insights":    {},
 	"doks":        {},
+	"registry":    {},
+	"functions":   {},
 }
 
 // registerAppTools registers the app platform tools with the MCP server.
@@ -48,6 +52,8 @@ func registerAppTools(s *server.MCPServer, c *godo.Client) error {
 // registerCommonTools registers the common tools with the MCP server.
 func registerCommonTools(s *server.MCPServer, c *godo.Client) error {
 	s.AddTools(common.NewRegionTools(c).Tools()...)
+	s.AddTools(common.NewTagTools(c).Tools()...)
+	s.AddTools(common.NewProjectTools(c).Tools()...)
 
 	return nil
 }
@@ -58,6 +64,7 @@ func registerDropletTools(s *server.MCPServer, c *godo.Client) error {
 	s.AddTools(droplet.NewDropletActionsTool(c).Tools()...)
 	s.AddTools(droplet.NewImagesTool(c).Tools()...)
 	s.AddTools(droplet.NewSizesTool(c).Tools()...)
+	s.AddTools(droplet.NewInventoryTool(c).Tools()...)
 	return nil
 }
 
@@ -66,6 +73,7 @@ func registerNetworkingTools(s *server.MCPServer, c *godo.Client) error {
 	s.AddTools(networking.NewCertificateTool(c).Tools()...)
 	s.AddTools(networking.NewDomainsTool(c).Tools()...)
 	s.AddTools(networking.NewFirewallTool(c).Tools()...)
+	s.AddTools(networking.NewLoadBalancerTool(c).Tools()...)
 	s.AddTools(networking.NewReservedIPTool(c).Tools()...)
 	// Partner attachments doesn't have much users so this has been disabled
 	// s.AddTools(networking.NewPartnerAttachmentTool(c).Tools()...)
@@ -105,7 +113,12 @@ func registerMarketplaceTools(s *server.MCPServer, c *godo.Client) error {
 func registerInsightsTools(s *server.MCPServer, c *godo.Client) error {
 	s.AddTools(insights.NewUptimeTool(c).Tools()...)
 	s.AddTools(insights.NewUptimeCheckAlertTool(c).Tools()...)
+	s.AddTools(insights.NewUptimeProvisionTool(c).Tools()...)
+	s.AddTools(insights.NewUptimeReportTool(c).Tools()...)
 	s.AddTools(insights.NewAlertPolicyTool(c).Tools()...)
+	s.AddTools(insights.NewAlertPolicyTemplateTool(c).Tools()...)
+	s.AddTools(insights.NewNotificationChannelTool(c).Tools()...)
+	s.AddTools(insights.NewMetricsTool(c).Tools()...)
 	return nil
 }
 
@@ -115,6 +128,22 @@ func registerDOKSTools(s *server.MCPServer, c *godo.Client) error {
 	return nil
 }
 
+// registerContainerRegistryTools registers the container registry tools with the MCP server.
+func registerContainerRegistryTools(s *server.MCPServer, c *godo.Client) error {
+	s.AddTools(containerregistry.NewRegistryTool(c).Tools()...)
+	s.AddTools(containerregistry.NewGarbageCollectionTool(c).Tools()...)
+
+	return nil
+}
+
+// registerFunctionsTools registers the Functions tools with the MCP server.
+func registerFunctionsTools(s *server.MCPServer, c *godo.Client) error {
+	s.AddTools(functions.NewNamespaceTool(c).Tools()...)
+	s.AddTools(functions.NewTriggerTool(c).Tools()...)
+
+	return nil
+}
+
 func registerDatabasesTools(s *server.MCPServer, c *godo.Client) error {
 	s.AddTools(dbaas.NewClusterTool(c).Tools()...)
 	s.AddTools(dbaas.NewFirewallTool(c).Tools()...)
@@ -177,6 +206,14 @@ func Register(logger *slog.Logger, s *server.MCPServer, c *godo.Client, services
 			if err := registerDOKSTools(s, c); err != nil {
 				return fmt.Errorf("failed to register DOKS tools: %w", err)
 			}
+		case "registry":
+			if err := registerContainerRegistryTools(s, c); err != nil {
+				return fmt.Errorf("failed to register container registry tools: %w", err)
+			}
+		case "functions":
+			if err := registerFunctionsTools(s, c); err != nil {
+				return fmt.Errorf("failed to register functions tools: %w", err)
+			}
 		default:
 			return fmt.Errorf("unsupported service: %s, supported service are: %v", svc, setToString(supportedServices))
 		}